@@ -0,0 +1,54 @@
+package glc
+
+import (
+	"context"
+	"time"
+)
+
+// ContextCache is for loops that call `GetContext` so often that decoding the
+// stack each time matters. Its Get method returns the context found by the last
+// full decode, and only decodes again once it has been called `Every` times or
+// `MaxAge` has passed since the last decode, whichever comes first.
+//
+// The staleness contract is exactly that: Get may return the context that was
+// bound up to Every-1 calls, or MaxAge, ago, even if the goroutine has since
+// entered a new `WithContext` scope or left the old one. So a ContextCache
+// belongs to one goroutine and one scope. Make it inside the scope, use it in
+// the loop, and drop it when the loop is done. It must not be shared between
+// goroutines.
+//
+// Go has no goroutine-local storage to keep such a cache in on the caller's
+// behalf, which is why there is no GetContextFast. Telling which goroutine is
+// calling costs more than a decode.
+type ContextCache struct {
+	// Every is the most calls to Get between decodes. Zero or one means
+	// every call decodes.
+	Every int
+	// MaxAge is the longest Get will go between decodes. Zero means no limit,
+	// and Get doesn't look at the clock at all.
+	MaxAge time.Duration
+
+	ctx     context.Context
+	calls   int
+	decoded time.Time
+}
+
+// Get returns the bound context, as `GetContext` does, but possibly stale, as
+// described by ContextCache.
+func (c *ContextCache) Get() context.Context {
+	if c.calls > 0 && c.calls < c.Every && (c.MaxAge <= 0 || time.Since(c.decoded) < c.MaxAge) {
+		c.calls++
+		return c.ctx
+	}
+	c.ctx = GetContext()
+	c.calls = 1
+	if c.MaxAge > 0 {
+		c.decoded = time.Now()
+	}
+	return c.ctx
+}
+
+// Invalidate makes the next call to Get decode the stack.
+func (c *ContextCache) Invalidate() {
+	c.calls = 0
+}
@@ -0,0 +1,58 @@
+package glc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestContextCache(t *testing.T) {
+	outer := context.WithValue(context.Background(), "scope", "outer")
+	inner := context.WithValue(context.Background(), "scope", "inner")
+	WithContext(outer, func() {
+		c := &ContextCache{Every: 3}
+		if got := c.Get(); got != outer {
+			t.Fatalf("got %v, want %v", got, outer)
+		}
+		WithContext(inner, func() {
+			// Two more calls from the cache, then a decode.
+			for i, want := range []context.Context{outer, outer, inner, inner} {
+				if got := c.Get(); got != want {
+					t.Errorf("call %d: got %v, want %v", i, got, want)
+				}
+			}
+		})
+		c.Invalidate()
+		if got := c.Get(); got != outer {
+			t.Errorf("after Invalidate: got %v, want %v", got, outer)
+		}
+
+		c = &ContextCache{Every: 1 << 30, MaxAge: time.Millisecond}
+		c.Get()
+		WithContext(inner, func() {
+			time.Sleep(2 * time.Millisecond)
+			if got := c.Get(); got != inner {
+				t.Errorf("after MaxAge: got %v, want %v", got, inner)
+			}
+		})
+
+		var zero ContextCache
+		WithContext(inner, func() {
+			if got := zero.Get(); got != inner {
+				t.Errorf("zero ContextCache: got %v, want %v", got, inner)
+			}
+		})
+		if got := zero.Get(); got != outer {
+			t.Errorf("zero ContextCache: got %v, want %v", got, outer)
+		}
+	})
+}
+
+func BenchmarkContextCache(b *testing.B) {
+	WithContext(context.Background(), func() {
+		c := &ContextCache{Every: 1000}
+		for i := 0; i < b.N; i++ {
+			c.Get()
+		}
+	})
+}
@@ -0,0 +1,134 @@
+// Command glcmigrate moves functions between taking a context.Context
+// parameter and getting their context from glc.
+//
+// Usage:
+//
+//	glcmigrate -mode to-glc -funcs f,g [-w] path...
+//	glcmigrate -mode from-glc -funcs f,g [-w] path...
+//
+// The paths are the files of one package, or directories holding them. Only
+// the named functions are migrated, and only functions without receivers.
+//
+// With -mode to-glc, each function loses its leading context.Context
+// parameter, and gets it from glc.GetContext instead, if it uses it. Calls to
+// the function from within the package change to match:
+//
+//   - Calls from other migrated functions passing their own, unmodified
+//     context just drop the argument, since that context is already bound.
+//   - Other calls that are statements on their own are wrapped in
+//     glc.WithContext, which binds the context they passed. So are go
+//     statements, since new goroutines don't inherit bindings, as long as
+//     the call's other arguments are constants.
+//   - Anything else, such as a call whose result is used, is left alone and
+//     reported, and won't compile until it's fixed by hand.
+//
+// Function literals that aren't called where they're written may run after the
+// binding has gone, or on another goroutine, so calls within them count as
+// other calls, and are wrapped in glc.WithContext or reported.
+//
+// Note that glc.GetContext returns nil where nothing is bound, where the
+// parameter may have been context.Background().
+//
+// With -mode from-glc, it goes the other way: each function gains a leading
+// context.Context parameter named for the variable it assigned
+// glc.GetContext() to as its first statement, or ctx if there's no such
+// statement, and uses it in place of glc.GetContext(). Migrated functions pass
+// their context on to the others they call, and calls wrapped in
+// glc.WithContext pass the context they bound. Other calls, including those
+// in function literals that aren't called where they're written, pass
+// glc.GetContext(), and are reported.
+//
+// Calls from other packages, calls through function values, and calls to
+// glc.GetContext within function literals are left for you to deal with.
+//
+// The rewritten files are printed, or written back to the files with -w.
+// Problems are reported on standard error, and glcmigrate exits with status 1
+// if there were any.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	mode  = flag.String("mode", "", "to-glc or from-glc")
+	funcs = flag.String("funcs", "", "comma-separated names of the functions to migrate")
+	write = flag.Bool("w", false, "write results to the files instead of standard output")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: glcmigrate -mode to-glc|from-glc -funcs f,g [-w] path...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if (*mode != "to-glc" && *mode != "from-glc") || *funcs == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	names, err := goFiles(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "glcmigrate: %v\n", err)
+		os.Exit(1)
+	}
+	srcs := make([][]byte, len(names))
+	for i, name := range names {
+		if srcs[i], err = os.ReadFile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "glcmigrate: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	out, warnings, err := migrate(*mode == "to-glc", strings.Split(*funcs, ","), names, srcs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "glcmigrate: %v\n", err)
+		os.Exit(1)
+	}
+	for i, src := range out {
+		if src == nil || bytes.Equal(src, srcs[i]) {
+			continue
+		}
+		if *write {
+			err = os.WriteFile(names[i], src, 0666)
+		} else {
+			_, err = fmt.Printf("// %s\n%s", names[i], src)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "glcmigrate: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	for _, w := range warnings {
+		fmt.Fprintln(os.Stderr, w)
+	}
+	if len(warnings) > 0 {
+		os.Exit(1)
+	}
+}
+
+// goFiles expands the directories in paths to the Go files within them.
+func goFiles(paths []string) ([]string, error) {
+	var names []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			names = append(names, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, matches...)
+	}
+	return names, nil
+}
@@ -0,0 +1,722 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	contextPath = "context"
+	glcPath     = "github.com/knusbaum/glc"
+)
+
+// migration is the state of one run over a package.
+type migration struct {
+	fset  *token.FileSet
+	toGlc bool
+	funcs map[string]bool
+	// migrated maps the declarations of the functions migrated so far to
+	// the name of their context parameter or variable. When migrating to
+	// glc, the name is empty if the function changes its context.
+	migrated map[*ast.FuncDecl]string
+	// params maps the declarations of the functions migrated to glc to the
+	// name of the parameter they lost.
+	params map[*ast.FuncDecl]string
+	// byName is the names of the migrated functions.
+	byName map[string]bool
+	// done is the calls already rewritten, which walkToGlc may come across
+	// again within the function literals it wraps them in.
+	done     map[*ast.CallExpr]bool
+	warnings []string
+}
+
+// file is the state of one file within a migration.
+type file struct {
+	*ast.File
+	changed bool
+	// ctx and glc are the names the file imports context and glc under,
+	// or will once it's been migrated.
+	ctx, glc string
+}
+
+// migrate migrates the named functions in the package made up of srcs, read
+// from the files with the given names. It returns the new source of each file,
+// or nil for those it hasn't changed, and a description of each problem found.
+func migrate(toGlc bool, funcs []string, names []string, srcs [][]byte) (out [][]byte, warnings []string, err error) {
+	m := &migration{
+		fset:     token.NewFileSet(),
+		toGlc:    toGlc,
+		funcs:    make(map[string]bool),
+		migrated: make(map[*ast.FuncDecl]string),
+		params:   make(map[*ast.FuncDecl]string),
+		byName:   make(map[string]bool),
+		done:     make(map[*ast.CallExpr]bool),
+	}
+	for _, name := range funcs {
+		m.funcs[name] = true
+	}
+	files := make([]*file, len(srcs))
+	for i, src := range srcs {
+		f, err := parser.ParseFile(m.fset, names[i], src, parser.ParseComments)
+		if err != nil {
+			return nil, nil, err
+		}
+		files[i] = &file{
+			File: f,
+			ctx:  importName(f, contextPath),
+			glc:  importName(f, glcPath),
+		}
+		if files[i].ctx == "" {
+			files[i].ctx = "context"
+		}
+		if files[i].glc == "" {
+			files[i].glc = "glc"
+		}
+	}
+
+	for _, f := range files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Body != nil && m.funcs[fd.Name.Name] {
+				if toGlc {
+					m.paramToGlc(f, fd)
+				} else {
+					m.paramFromGlc(f, fd)
+				}
+			}
+		}
+	}
+	for name := range m.funcs {
+		if !m.byName[name] {
+			m.warnings = append(m.warnings, fmt.Sprintf("%s: not migrated", name))
+		}
+	}
+	for _, f := range files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Body != nil {
+				if toGlc {
+					m.callsToGlc(f, fd)
+					m.getContext(f, fd)
+				} else {
+					m.callsFromGlc(f, fd)
+				}
+			}
+		}
+	}
+
+	out = make([][]byte, len(files))
+	for i, f := range files {
+		if !f.changed {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := format.Node(&buf, m.fset, f.File); err != nil {
+			return nil, nil, err
+		}
+		if out[i], err = fixImports(buf.Bytes(), f); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", names[i], err)
+		}
+	}
+	sort.Strings(m.warnings)
+	return out, m.warnings, nil
+}
+
+func (m *migration) warnf(pos token.Pos, format string, args ...any) {
+	m.warnings = append(m.warnings, fmt.Sprintf("%s: %s", m.fset.Position(pos), fmt.Sprintf(format, args...)))
+}
+
+// paramToGlc removes fd's context parameter.
+func (m *migration) paramToGlc(f *file, fd *ast.FuncDecl) {
+	params := fd.Type.Params.List
+	if len(params) == 0 || !isSelector(params[0].Type, f.ctx, "Context") || len(params[0].Names) != 1 {
+		m.warnf(fd.Pos(), "%s: first parameter isn't a lone context.Context", fd.Name.Name)
+		return
+	}
+	name := params[0].Names[0].Name
+	fd.Type.Params.List = params[1:]
+	// Calls passing name can only drop it if it's still the bound context.
+	if name == "_" || assigns(fd.Body, name) {
+		m.migrated[fd] = ""
+	} else {
+		m.migrated[fd] = name
+	}
+	m.params[fd] = name
+	m.byName[fd.Name.Name] = true
+	f.changed = true
+}
+
+// getContext starts fd with a call to glc.GetContext, if it still uses the
+// context parameter paramToGlc removed.
+func (m *migration) getContext(f *file, fd *ast.FuncDecl) {
+	name, ok := m.params[fd]
+	if !ok || name == "_" || !uses(fd.Body, name) {
+		return
+	}
+	get := &ast.AssignStmt{
+		Lhs: []ast.Expr{ast.NewIdent(name)},
+		Tok: token.DEFINE,
+		Rhs: []ast.Expr{call(f.glc, "GetContext")},
+	}
+	fd.Body.List = append([]ast.Stmt{get}, fd.Body.List...)
+}
+
+// callsToGlc rewrites the calls within fd to migrated functions.
+func (m *migration) callsToGlc(f *file, fd *ast.FuncDecl) {
+	m.walkToGlc(f, fd.Body, m.migrated[fd])
+}
+
+// walkToGlc rewrites the calls within n to migrated functions. ctx is the name
+// of the context that is bound where n runs, if there is one.
+//
+// Function literals that aren't called on the spot may run anywhere, after the
+// binding has gone or on another goroutine, so nothing is known to be bound
+// within them.
+func (m *migration) walkToGlc(f *file, n ast.Node, ctx string) {
+	done := m.done
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			m.walkToGlc(f, n.Body, "")
+			return false
+		case *ast.ExprStmt:
+			if c, ok := n.X.(*ast.CallExpr); ok && m.isTarget(c) && !done[c] {
+				done[c] = true
+				if isIdent(c.Args[0], ctx) {
+					c.Args = c.Args[1:]
+				} else {
+					n.X = f.bind(c)
+				}
+				f.changed = true
+			}
+		case *ast.GoStmt:
+			if lit, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				// Nothing is bound on the new goroutine.
+				m.walkToGlc(f, lit.Body, "")
+				for _, a := range n.Call.Args {
+					m.walkToGlc(f, a, ctx)
+				}
+				return false
+			}
+			if m.isTarget(n.Call) && !done[n.Call] {
+				done[n.Call] = true
+				if !constants(n.Call.Args[1:]) {
+					m.warnf(n.Pos(), "go %s: bind its context with %s.WithContext by hand", n.Call.Fun.(*ast.Ident).Name, f.glc)
+					return true
+				}
+				n.Call = f.bind(n.Call)
+				f.changed = true
+			}
+		case *ast.CallExpr:
+			if lit, ok := n.Fun.(*ast.FuncLit); ok {
+				m.walkToGlc(f, lit.Body, ctx)
+				for _, a := range n.Args {
+					m.walkToGlc(f, a, ctx)
+				}
+				return false
+			}
+			if m.isTarget(n) && !done[n] {
+				if isIdent(n.Args[0], ctx) {
+					n.Args = n.Args[1:]
+					f.changed = true
+				} else {
+					m.warnf(n.Pos(), "%s: bind its context with %s.WithContext by hand", n.Fun.(*ast.Ident).Name, f.glc)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// bind returns c, without its context argument, bound to that context with
+// glc.WithContext.
+func (f *file) bind(c *ast.CallExpr) *ast.CallExpr {
+	ctx := c.Args[0]
+	c.Args = c.Args[1:]
+	lit := &ast.FuncLit{
+		Type: &ast.FuncType{Params: &ast.FieldList{}},
+		Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ExprStmt{X: c}}},
+	}
+	return call(f.glc, "WithContext", ctx, lit)
+}
+
+// paramFromGlc gives fd a context parameter.
+func (m *migration) paramFromGlc(f *file, fd *ast.FuncDecl) {
+	params := fd.Type.Params.List
+	if len(params) > 0 && isSelector(params[0].Type, f.ctx, "Context") {
+		m.warnf(fd.Pos(), "%s: already takes a context.Context", fd.Name.Name)
+		return
+	}
+	name := "ctx"
+	if len(fd.Body.List) > 0 {
+		if a, ok := fd.Body.List[0].(*ast.AssignStmt); ok && a.Tok == token.DEFINE && len(a.Lhs) == 1 && len(a.Rhs) == 1 && m.isGetContext(f, a.Rhs[0]) {
+			name = a.Lhs[0].(*ast.Ident).Name
+			fd.Body.List = fd.Body.List[1:]
+			// Move the brace down to where a was, so that there's no
+			// blank line in its place.
+			fd.Body.Lbrace = a.End() - 1
+		} else if uses(fd.Body, name) {
+			m.warnf(fd.Pos(), "%s: already has something called %s", fd.Name.Name, name)
+			return
+		}
+	}
+	replaceExprs(fd.Body, func(e ast.Expr) ast.Expr {
+		if m.isGetContext(f, e) {
+			return ast.NewIdent(name)
+		}
+		return nil
+	})
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if e, ok := n.(ast.Expr); ok && m.isGetContext(f, e) {
+			m.warnf(n.Pos(), "%s: %s.GetContext() in a function literal", fd.Name.Name, f.glc)
+		}
+		return true
+	})
+	param := &ast.Field{
+		Names: []*ast.Ident{ast.NewIdent(name)},
+		Type:  &ast.SelectorExpr{X: ast.NewIdent(f.ctx), Sel: ast.NewIdent("Context")},
+	}
+	fd.Type.Params.List = append([]*ast.Field{param}, params...)
+	m.migrated[fd] = name
+	m.byName[fd.Name.Name] = true
+	f.changed = true
+}
+
+// callsFromGlc rewrites the calls within fd to migrated functions.
+func (m *migration) callsFromGlc(f *file, fd *ast.FuncDecl) {
+	var ctx ast.Expr
+	if name, ok := m.migrated[fd]; ok {
+		ctx = ast.NewIdent(name)
+	}
+	m.walkFromGlc(f, fd.Body, ctx)
+}
+
+// walkFromGlc rewrites the calls within n to migrated functions. ctx is the
+// context bound where n runs, if it's known. As with walkToGlc, it isn't known
+// within function literals that aren't called on the spot.
+func (m *migration) walkFromGlc(f *file, n ast.Node, ctx ast.Expr) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		var c *ast.CallExpr
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			m.walkFromGlc(f, n.Body, nil)
+			return false
+		case *ast.GoStmt:
+			if lit, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				// Nothing is bound on the new goroutine.
+				m.walkFromGlc(f, lit.Body, nil)
+				for _, a := range n.Call.Args {
+					m.walkFromGlc(f, a, ctx)
+				}
+				return false
+			}
+			return true
+		case *ast.CallExpr:
+			c = n
+		default:
+			return true
+		}
+		if lit, ok := c.Fun.(*ast.FuncLit); ok {
+			m.walkFromGlc(f, lit.Body, ctx)
+			for _, a := range c.Args {
+				m.walkFromGlc(f, a, ctx)
+			}
+			return false
+		}
+		if inner := m.bound(f, c); inner != nil {
+			// Unwrap the call, and give it the context it was bound to.
+			for _, a := range inner.Args {
+				m.walkFromGlc(f, a, ctx)
+			}
+			// inner was on a line of its own. Forget where, so that it
+			// prints on the one c was on.
+			clearPos(inner)
+			inner.Args = append([]ast.Expr{c.Args[0]}, inner.Args...)
+			inner.Lparen, inner.Rparen = c.Lparen, c.Rparen
+			*c = *inner
+			f.changed = true
+			return false
+		}
+		if isSelector(c.Fun, f.glc, "WithContext") && len(c.Args) == 2 {
+			if lit, ok := c.Args[1].(*ast.FuncLit); ok {
+				// Within lit, the context bound is c's first argument.
+				var bound ast.Expr
+				if id, ok := c.Args[0].(*ast.Ident); ok {
+					bound = ast.NewIdent(id.Name)
+				}
+				m.walkFromGlc(f, c.Args[0], ctx)
+				m.walkFromGlc(f, lit.Body, bound)
+				return false
+			}
+		}
+		if m.isTarget(c) {
+			arg := ctx
+			if arg == nil {
+				arg = call(f.glc, "GetContext")
+				m.warnf(c.Pos(), "%s: pass it a context instead of %s.GetContext()", c.Fun.(*ast.Ident).Name, f.glc)
+			} else {
+				arg = ast.NewIdent(arg.(*ast.Ident).Name)
+			}
+			c.Args = append([]ast.Expr{arg}, c.Args...)
+			f.changed = true
+		}
+		return true
+	})
+}
+
+// bound returns the call c binds a context for, if c is a call to
+// glc.WithContext with a function literal doing nothing but call a migrated
+// function.
+func (m *migration) bound(f *file, c *ast.CallExpr) *ast.CallExpr {
+	if c == nil || !isSelector(c.Fun, f.glc, "WithContext") || len(c.Args) != 2 {
+		return nil
+	}
+	lit, ok := c.Args[1].(*ast.FuncLit)
+	if !ok || len(lit.Type.Params.List) != 0 || len(lit.Body.List) != 1 {
+		return nil
+	}
+	s, ok := lit.Body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return nil
+	}
+	if inner, ok := s.X.(*ast.CallExpr); ok && m.isTarget(inner) {
+		return inner
+	}
+	return nil
+}
+
+// isTarget is whether c calls a migrated function, and, when migrating to glc,
+// has the context argument to be a call to its pre-migration self.
+func (m *migration) isTarget(c *ast.CallExpr) bool {
+	id, ok := c.Fun.(*ast.Ident)
+	return ok && m.byName[id.Name] && (!m.toGlc || len(c.Args) > 0)
+}
+
+func (m *migration) isGetContext(f *file, e ast.Expr) bool {
+	c, ok := e.(*ast.CallExpr)
+	return ok && len(c.Args) == 0 && isSelector(c.Fun, f.glc, "GetContext")
+}
+
+// call returns a call to pkg.name with the given arguments.
+func call(pkg, name string, args ...ast.Expr) *ast.CallExpr {
+	return &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(name)},
+		Args: args,
+	}
+}
+
+func isSelector(e ast.Expr, pkg, name string) bool {
+	sel, ok := e.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == name && isIdent(sel.X, pkg)
+}
+
+func isIdent(e ast.Expr, name string) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && name != "" && id.Name == name
+}
+
+// uses is whether anything called name appears within n.
+func uses(n ast.Node, name string) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// assigns is whether anything called name is assigned to or declared within n,
+// so that name may not mean the same thing throughout.
+func assigns(n ast.Node, name string) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, l := range n.Lhs {
+				found = found || isIdent(l, name)
+			}
+		case *ast.RangeStmt:
+			found = isIdent(n.Key, name) || isIdent(n.Value, name)
+		case *ast.ValueSpec:
+			for _, id := range n.Names {
+				found = found || id.Name == name
+			}
+		case *ast.FuncType:
+			for _, p := range n.Params.List {
+				for _, id := range p.Names {
+					found = found || id.Name == name
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// constants is whether every one of args is a literal constant.
+func constants(args []ast.Expr) bool {
+	for _, a := range args {
+		if _, ok := a.(*ast.BasicLit); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	exprType   = reflect.TypeOf((*ast.Expr)(nil)).Elem()
+	objectType = reflect.TypeOf((*ast.Object)(nil))
+	scopeType  = reflect.TypeOf((*ast.Scope)(nil))
+	funcLit    = reflect.TypeOf((*ast.FuncLit)(nil))
+	posType    = reflect.TypeOf(token.NoPos)
+)
+
+// replaceExprs replaces every expression e within n, other than those within
+// function literals, with f(e), unless that's nil.
+func replaceExprs(n ast.Node, f func(ast.Expr) ast.Expr) {
+	var walk func(v reflect.Value)
+	replace := func(v reflect.Value) bool {
+		if v.Type() != exprType || v.IsNil() {
+			return false
+		}
+		r := f(v.Interface().(ast.Expr))
+		if r == nil {
+			return false
+		}
+		v.Set(reflect.ValueOf(r))
+		return true
+	}
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Pointer:
+			if v.IsNil() || v.Type() == objectType || v.Type() == scopeType || v.Type() == funcLit {
+				return
+			}
+			walk(v.Elem())
+		case reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if !replace(v.Field(i)) {
+					walk(v.Field(i))
+				}
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				if !replace(v.Index(i)) {
+					walk(v.Index(i))
+				}
+			}
+		}
+	}
+	walk(reflect.ValueOf(n))
+}
+
+// clearPos forgets the positions of everything within n.
+func clearPos(n ast.Node) {
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Pointer:
+			if !v.IsNil() && v.Type() != objectType && v.Type() != scopeType {
+				walk(v.Elem())
+			}
+		case reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				walk(v.Field(i))
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Int:
+			if v.Type() == posType && v.CanSet() {
+				v.SetInt(int64(token.NoPos))
+			}
+		}
+	}
+	walk(reflect.ValueOf(n))
+}
+
+// importName returns the name f imports path under, or "" if it doesn't.
+func importName(f *ast.File, path string) string {
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+			return path[strings.LastIndex(path, "/")+1:]
+		}
+	}
+	return ""
+}
+
+// fixImports adds the imports of context and glc to src, the source of f, if
+// it now uses them, and removes them if it no longer does.
+func fixImports(src []byte, f *file) ([]byte, error) {
+	imports := []struct{ path, name string }{{contextPath, f.ctx}, {glcPath, f.glc}}
+
+	fset, af, used, err := parseUses(src)
+	if err != nil {
+		return nil, err
+	}
+	var edits []edit
+	for _, imp := range imports {
+		if !used[imp.name] && importName(af, imp.path) != "" {
+			start, end := removeImport(af, imp.path)
+			edits = append(edits, edit{fset.Position(start).Offset, fset.Position(end).Offset, ""})
+		}
+	}
+	src = applyEdits(src, edits)
+
+	fset, af, used, err = parseUses(src)
+	if err != nil {
+		return nil, err
+	}
+	var std, other []string
+	for _, imp := range imports {
+		if used[imp.name] && importName(af, imp.path) == "" {
+			if imp.path == contextPath {
+				std = append(std, strconv.Quote(imp.path))
+			} else {
+				other = append(other, strconv.Quote(imp.path))
+			}
+		}
+	}
+	edits = nil
+	if len(std)+len(other) > 0 {
+		var decl *ast.GenDecl
+		for _, d := range af.Decls {
+			if g, ok := d.(*ast.GenDecl); ok && g.Tok == token.IMPORT {
+				decl = g
+				break
+			}
+		}
+		// Standard library imports go first, and others last, after a
+		// blank line.
+		switch {
+		case decl == nil && len(std)+len(other) == 1:
+			at := fset.Position(af.Name.End()).Offset
+			edits = append(edits, edit{at, at, "\n\nimport " + strings.Join(append(std, other...), "")})
+		case decl == nil:
+			at := fset.Position(af.Name.End()).Offset
+			edits = append(edits, edit{at, at, "\n\nimport (\n" + importGroups(std, other) + ")"})
+		case decl.Lparen.IsValid():
+			start, end := fset.Position(decl.Lparen).Offset+1, fset.Position(decl.Rparen).Offset
+			if len(std) > 0 {
+				edits = append(edits, edit{start, start, "\n" + strings.TrimSuffix(joinLines(std), "\n")})
+			}
+			if len(other) > 0 {
+				edits = append(edits, edit{end, end, "\n" + joinLines(other)})
+			}
+		default:
+			// A single import without parentheses.
+			start, end := fset.Position(decl.Specs[0].Pos()).Offset, fset.Position(decl.End()).Offset
+			existing := string(src[start:end])
+			if strings.HasPrefix(strings.Trim(existing, "\""), "github.com/") {
+				other = append(other, existing)
+			} else {
+				std = append(std, existing)
+			}
+			edits = append(edits, edit{start, end, "(\n" + importGroups(std, other) + ")"})
+		}
+	}
+	return format.Source(applyEdits(src, edits))
+}
+
+// parseUses parses src, and finds the names of everything it selects from.
+func parseUses(src []byte) (*token.FileSet, *ast.File, map[string]bool, error) {
+	fset := token.NewFileSet()
+	af, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	used := make(map[string]bool)
+	ast.Inspect(af, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	return fset, af, used, nil
+}
+
+// edit replaces src[start:end] with text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// applyEdits applies edits, which mustn't overlap, to src.
+func applyEdits(src []byte, edits []edit) []byte {
+	// Apply the edits from the end back, so that each one's offsets are
+	// still good.
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	for _, e := range edits {
+		src = append(src[:e.start:e.start], append([]byte(e.text), src[e.end:]...)...)
+	}
+	return src
+}
+
+// removeImport returns the extent of the source to remove to remove f's import
+// of path: from the end of whatever precedes it in its declaration, so as not to
+// leave a blank line behind, or the whole declaration if there's nothing else
+// in it.
+func removeImport(f *ast.File, path string) (start, end token.Pos) {
+	for _, d := range f.Decls {
+		g, ok := d.(*ast.GenDecl)
+		if !ok || g.Tok != token.IMPORT {
+			continue
+		}
+		for i, spec := range g.Specs {
+			if p, _ := strconv.Unquote(spec.(*ast.ImportSpec).Path.Value); p != path {
+				continue
+			}
+			switch {
+			case len(g.Specs) == 1:
+				return g.Pos(), g.End()
+			case i == 0:
+				return g.Lparen + 1, spec.End()
+			default:
+				return g.Specs[i-1].End(), spec.End()
+			}
+		}
+	}
+	return token.NoPos, token.NoPos
+}
+
+// importGroups returns the lines of an import declaration importing each
+// group of paths, with blank lines between the groups.
+func importGroups(groups ...[]string) string {
+	var parts []string
+	for _, g := range groups {
+		if len(g) > 0 {
+			parts = append(parts, joinLines(g))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func joinLines(lines []string) string {
+	var b bytes.Buffer
+	for _, l := range lines {
+		b.WriteString("\t" + l + "\n")
+	}
+	return b.String()
+}
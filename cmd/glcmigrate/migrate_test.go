@@ -0,0 +1,322 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const threaded = `package p
+
+import (
+	"context"
+	"net/http"
+)
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	load(r.Context(), r.URL.Path)
+	go audit(r.Context(), "handled")
+}
+
+func load(ctx context.Context, path string) {
+	if ctx.Err() != nil {
+		return
+	}
+	fetch(ctx, path)
+}
+
+func fetch(ctx context.Context, path string) {
+	lookup(ctx, path)
+}
+
+func audit(ctx context.Context, event string) {
+	lookup(ctx, event)
+}
+
+func lookup(ctx context.Context, key string) {
+	_ = ctx.Value(key)
+}
+`
+
+const scoped = `package p
+
+import (
+	"net/http"
+
+	"github.com/knusbaum/glc"
+)
+
+func handle(w http.ResponseWriter, r *http.Request) {
+	glc.WithContext(r.Context(), func() {
+		load(r.URL.Path)
+	})
+	go glc.WithContext(r.Context(), func() {
+		audit("handled")
+	})
+}
+
+func load(path string) {
+	ctx := glc.GetContext()
+	if ctx.Err() != nil {
+		return
+	}
+	fetch(path)
+}
+
+func fetch(path string) {
+	lookup(path)
+}
+
+func audit(event string) {
+	lookup(event)
+}
+
+func lookup(key string) {
+	ctx := glc.GetContext()
+	_ = ctx.Value(key)
+}
+`
+
+var all = []string{"load", "fetch", "audit", "lookup"}
+
+func migrateOne(t *testing.T, toGlc bool, funcs []string, src string) (string, []string) {
+	t.Helper()
+	out, warnings, err := migrate(toGlc, funcs, []string{"p.go"}, [][]byte{[]byte(src)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0] == nil {
+		return src, warnings
+	}
+	return string(out[0]), warnings
+}
+
+func TestToGlc(t *testing.T) {
+	got, warnings := migrateOne(t, true, all, threaded)
+	if len(warnings) > 0 {
+		t.Errorf("warnings: %q", warnings)
+	}
+	if got != scoped {
+		t.Errorf("got:\n%s\nwant:\n%s", got, scoped)
+	}
+}
+
+func TestFromGlc(t *testing.T) {
+	got, warnings := migrateOne(t, false, all, scoped)
+	if len(warnings) > 0 {
+		t.Errorf("warnings: %q", warnings)
+	}
+	if got != threaded {
+		t.Errorf("got:\n%s\nwant:\n%s", got, threaded)
+	}
+}
+
+func TestToGlcByHand(t *testing.T) {
+	src := `package p
+
+import "context"
+
+func get(ctx context.Context) int {
+	return 1
+}
+
+func use(ctx context.Context, n int) {
+	_ = get(ctx) + n
+	go get(ctx)
+	for i := 0; i < n; i++ {
+		go use(ctx, i)
+	}
+}
+`
+	got, warnings := migrateOne(t, true, []string{"get", "missing"}, src)
+	want := []string{
+		"missing: not migrated",
+		"p.go:10:6: get: bind its context with glc.WithContext by hand",
+	}
+	if strings.Join(warnings, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got warnings:\n%s\nwant:\n%s", strings.Join(warnings, "\n"), strings.Join(want, "\n"))
+	}
+	if !strings.Contains(got, "go glc.WithContext(ctx, func() {\n\t\tget()\n\t})") || !strings.Contains(got, `"github.com/knusbaum/glc"`) {
+		t.Errorf("go statement not bound:\n%s", got)
+	}
+	if !strings.Contains(got, "func get() int {") {
+		t.Errorf("get kept its parameter:\n%s", got)
+	}
+
+	// A go statement whose other arguments would be evaluated late.
+	_, warnings = migrateOne(t, true, []string{"use"}, src)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "go use: bind its context") {
+		t.Errorf("got warnings %q, want one about go use", warnings)
+	}
+}
+
+func TestFromGlcByHand(t *testing.T) {
+	src := `package p
+
+import "github.com/knusbaum/glc"
+
+func get() int {
+	return len(glc.GetContext().Value("k").(string))
+}
+
+func outside() {
+	println(get())
+	glc.WithContext(nil, func() {
+		println(get())
+		glc.GetContext()
+	})
+}
+`
+	got, warnings := migrateOne(t, false, []string{"get"}, src)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "p.go:10:10: get: pass it a context") {
+		t.Errorf("got warnings %q, want one about line 10", warnings)
+	}
+	for _, want := range []string{
+		"func get(ctx context.Context) int {",
+		`return len(ctx.Value("k").(string))`,
+		"println(get(glc.GetContext()))",
+		"println(get(nil))",
+		`"context"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("no %q in:\n%s", want, got)
+		}
+	}
+}
+
+// Function literals that aren't called on the spot may run after the binding
+// has gone, so calls within them are bound to the context they passed.
+const literals = `package p
+
+import "context"
+
+func handle(ctx context.Context, run func(func())) {
+	run(func() {
+		lookup(ctx, "run")
+	})
+	x := func() {
+		lookup(ctx, "go")
+	}
+	go x()
+	func() {
+		lookup(ctx, "called")
+	}()
+}
+
+func lookup(ctx context.Context, key string) {
+	_ = ctx.Value(key)
+}
+`
+
+const literalsScoped = `package p
+
+import "github.com/knusbaum/glc"
+
+func handle(run func(func())) {
+	ctx := glc.GetContext()
+	run(func() {
+		glc.WithContext(ctx, func() {
+			lookup("run")
+		})
+	})
+	x := func() {
+		glc.WithContext(ctx, func() {
+			lookup("go")
+		})
+	}
+	go x()
+	func() {
+		lookup("called")
+	}()
+}
+
+func lookup(key string) {
+	ctx := glc.GetContext()
+	_ = ctx.Value(key)
+}
+`
+
+func TestToGlcLiterals(t *testing.T) {
+	got, warnings := migrateOne(t, true, []string{"handle", "lookup"}, literals)
+	if len(warnings) > 0 {
+		t.Errorf("warnings: %q", warnings)
+	}
+	if got != literalsScoped {
+		t.Errorf("got:\n%s\nwant:\n%s", got, literalsScoped)
+	}
+
+	// Going back, calls in literals that aren't called on the spot get
+	// whatever is bound when they run.
+	src := `package p
+
+import "github.com/knusbaum/glc"
+
+func handle(run func(func())) {
+	run(func() {
+		lookup("run")
+	})
+}
+
+func lookup(key string) {
+	_ = glc.GetContext().Value(key)
+}
+`
+	got, warnings = migrateOne(t, false, []string{"handle", "lookup"}, src)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "p.go:7:3: lookup: pass it a context") {
+		t.Errorf("got warnings %q, want one about line 7", warnings)
+	}
+	if !strings.Contains(got, `lookup(glc.GetContext(), "run")`) {
+		t.Errorf("call in literal not given glc.GetContext():\n%s", got)
+	}
+}
+
+// A context declared with var shadows the parameter, so calls passing it can't
+// just drop it.
+const shadowed = `package p
+
+import "context"
+
+func handle(ctx context.Context, v string) {
+	if v != "" {
+		var ctx = context.WithValue(ctx, "v", v)
+		lookup(ctx, "v")
+	}
+}
+
+func lookup(ctx context.Context, key string) {
+	_ = ctx.Value(key)
+}
+`
+
+const shadowedScoped = `package p
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+)
+
+func handle(v string) {
+	ctx := glc.GetContext()
+	if v != "" {
+		var ctx = context.WithValue(ctx, "v", v)
+		glc.WithContext(ctx, func() {
+			lookup("v")
+		})
+	}
+}
+
+func lookup(key string) {
+	ctx := glc.GetContext()
+	_ = ctx.Value(key)
+}
+`
+
+func TestToGlcShadowed(t *testing.T) {
+	got, warnings := migrateOne(t, true, []string{"handle", "lookup"}, shadowed)
+	if len(warnings) > 0 {
+		t.Errorf("warnings: %q", warnings)
+	}
+	if got != shadowedScoped {
+		t.Errorf("got:\n%s\nwant:\n%s", got, shadowedScoped)
+	}
+}
@@ -0,0 +1,238 @@
+// Command glcstress is a soak test for glc. It runs WithContext and GetContext
+// concurrently on many goroutines, at varied stack depths and nesting levels,
+// while forcing garbage collections so that stacks are shrunk and moved
+// underneath the decoder. It reports how many decodes found the wrong context
+// or none at all, and how long they took.
+//
+// Usage:
+//
+//	glcstress [flags]
+//
+// It exits with status 1 if any decode went wrong.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"os"
+	"os/signal"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+var (
+	goroutines = flag.Int("goroutines", 100, "number of goroutines binding and decoding")
+	depth      = flag.Int("depth", 300, "greatest number of frames between a binding and its decode")
+	nest       = flag.Int("nest", 3, "greatest number of bindings nested on one stack")
+	gcEvery    = flag.Duration("gc", 10*time.Millisecond, "time between forced garbage collections, or 0 for none")
+	duration   = flag.Duration("duration", time.Minute, "how long to run for, or 0 to run until interrupted")
+	report     = flag.Duration("report", 10*time.Second, "time between progress reports")
+)
+
+type key struct{}
+
+type counters struct {
+	checks, wrong, missing atomic.Int64
+	latency                histogram
+}
+
+func main() {
+	flag.Parse()
+	if *goroutines < 1 || *depth < 0 || *nest < 1 {
+		fmt.Fprintln(os.Stderr, "glcstress: -goroutines and -nest must be at least 1, and -depth at least 0")
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *duration)
+		defer cancel()
+	}
+
+	var wg sync.WaitGroup
+	if *gcEvery > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			t := time.NewTicker(*gcEvery)
+			defer t.Stop()
+			for i := 0; ; i++ {
+				select {
+				case <-ctx.Done():
+					return
+				case <-t.C:
+				}
+				// GC shrinks stacks that are using less than a quarter
+				// of their space, which moves them.
+				if i%10 == 0 {
+					debug.FreeOSMemory()
+				} else {
+					runtime.GC()
+				}
+			}
+		}()
+	}
+
+	workers := make([]*counters, *goroutines)
+	for g := range workers {
+		c := new(counters)
+		workers[g] = c
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			work(ctx, g, c)
+		}(g)
+	}
+
+	start := time.Now()
+	t := time.NewTicker(*report)
+	defer t.Stop()
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-t.C:
+			summarize(time.Since(start), workers)
+		}
+	}
+	wg.Wait()
+	if total := summarize(time.Since(start), workers); total.wrong.Load() > 0 || total.missing.Load() > 0 {
+		os.Exit(1)
+	}
+}
+
+// work binds and decodes until ctx is done.
+func work(ctx context.Context, g int, c *counters) {
+	r := rand.New(rand.NewSource(int64(g)))
+	for i := uint64(0); ctx.Err() == nil; i++ {
+		levels := 1 + r.Intn(*nest)
+		bind(c, r, uint64(g)<<40|i<<8, 0, levels)
+	}
+}
+
+// bind binds a context for the given level of nesting, and checks that it can
+// be decoded from further up the stack before and after binding the next
+// level.
+func bind(c *counters, r *rand.Rand, want uint64, level, levels int) {
+	if level == levels {
+		return
+	}
+	want++
+	glc.WithContext(context.WithValue(context.Background(), key{}, want), func() {
+		stack(r.Intn(*depth+1), func() {
+			check(c, want)
+			bind(c, r, want, level+1, levels)
+			check(c, want)
+		})
+	})
+}
+
+func check(c *counters, want uint64) {
+	start := time.Now()
+	ctx := glc.GetContext()
+	c.latency.record(time.Since(start))
+	c.checks.Add(1)
+	switch {
+	case ctx == nil:
+		c.missing.Add(1)
+	case ctx.Value(key{}) != want:
+		c.wrong.Add(1)
+		fmt.Fprintf(os.Stderr, "glcstress: got context for %v, want %v\n", ctx.Value(key{}), want)
+	}
+}
+
+//go:noinline
+func stack(n int, f func()) {
+	if n > 0 {
+		stack(n-1, f)
+		return
+	}
+	f()
+}
+
+// summarize prints the totals over all workers so far, and returns them.
+func summarize(elapsed time.Duration, workers []*counters) *counters {
+	total := new(counters)
+	for _, c := range workers {
+		total.checks.Add(c.checks.Load())
+		total.wrong.Add(c.wrong.Load())
+		total.missing.Add(c.missing.Load())
+		total.latency.add(&c.latency)
+	}
+	checks := total.checks.Load()
+	fmt.Printf("%v: %d checks (%.0f/s), %d wrong, %d missing; latency p50 %v p90 %v p99 %v p99.9 %v max %v; %d bindings live\n",
+		elapsed.Round(time.Second), checks, float64(checks)/elapsed.Seconds(),
+		total.wrong.Load(), total.missing.Load(),
+		total.latency.quantile(0.5), total.latency.quantile(0.9),
+		total.latency.quantile(0.99), total.latency.quantile(0.999),
+		total.latency.quantile(1), glc.ActiveBindings())
+	return total
+}
+
+// histogram counts durations in buckets an eighth of a power of two wide, so
+// that it can run for hours in fixed space and still give quantiles to within
+// about 12%.
+type histogram [histBuckets]atomic.Int64
+
+// histBuckets is enough buckets for the longest time.Duration: eight below 8ns,
+// then eight for each power of two from 8ns up to 1<<62ns.
+const histBuckets = 8 + 60*8
+
+func bucket(d time.Duration) int {
+	v := uint64(d)
+	if d < 0 {
+		v = 0
+	}
+	if v < 8 {
+		return int(v)
+	}
+	e := bits.Len64(v) - 1
+	return (e-2)*8 + int(v>>(e-3)&7)
+}
+
+// bucketMin is the smallest duration that falls into bucket i.
+func bucketMin(i int) time.Duration {
+	if i < 8 {
+		return time.Duration(i)
+	}
+	e := i/8 + 2
+	return time.Duration(uint64(8+i%8) << (e - 3))
+}
+
+func (h *histogram) record(d time.Duration) {
+	h[bucket(d)].Add(1)
+}
+
+func (h *histogram) add(o *histogram) {
+	for i := range h {
+		h[i].Add(o[i].Load())
+	}
+}
+
+// quantile returns the lower bound of the bucket holding the q'th quantile.
+func (h *histogram) quantile(q float64) time.Duration {
+	var n int64
+	for i := range h {
+		n += h[i].Load()
+	}
+	if n == 0 {
+		return 0
+	}
+	rank := int64(q * float64(n-1))
+	for i := range h {
+		if rank -= h[i].Load(); rank < 0 {
+			return bucketMin(i)
+		}
+	}
+	return bucketMin(len(h) - 1)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuckets(t *testing.T) {
+	for i := 0; i < histBuckets; i++ {
+		min := bucketMin(i)
+		if got := bucket(min); got != i {
+			t.Errorf("bucket(bucketMin(%d) = %v) = %d", i, min, got)
+		}
+		if i > 0 {
+			if got := bucket(min - 1); got != i-1 {
+				t.Errorf("bucket(%v) = %d, want %d", min-1, got, i-1)
+			}
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	var h histogram
+	for i := 1; i <= 100; i++ {
+		h.record(time.Duration(i) * time.Microsecond)
+	}
+	for _, tc := range []struct {
+		q    float64
+		want time.Duration
+	}{
+		{0, time.Microsecond},
+		{0.5, 50 * time.Microsecond},
+		{1, 100 * time.Microsecond},
+	} {
+		got := h.quantile(tc.q)
+		if got > tc.want || float64(got) < 0.875*float64(tc.want) {
+			t.Errorf("quantile(%v) = %v, want within an eighth below %v", tc.q, got, tc.want)
+		}
+	}
+}
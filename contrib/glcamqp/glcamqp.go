@@ -0,0 +1,47 @@
+// Package glcamqp consumes AMQP deliveries from amqp091-go, with a context for
+// each delivery bound by glc.WithContext around its handling, so that code
+// processing the delivery can get at it with glc.GetContext.
+//
+// glcamqp is a module of its own, so that glc doesn't depend on amqp091-go.
+package glcamqp
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type deliveryKey struct{}
+
+// DeliveryFrom returns the delivery stored in `ctx` by Consume, if any. Its
+// Exchange and RoutingKey say where it came from.
+func DeliveryFrom(ctx context.Context) (*amqp.Delivery, bool) {
+	d, ok := ctx.Value(deliveryKey{}).(*amqp.Delivery)
+	return d, ok
+}
+
+// Consume calls `f` for each delivery received from `deliveries`, such as those
+// returned by amqp.Channel.Consume, with a context for the delivery bound by
+// `glc.WithContext`. The context is derived from `ctx`, so cancelling `ctx`
+// cancels the delivery being handled, and it carries the delivery, which
+// DeliveryFrom returns.
+//
+// Consume returns nil once `deliveries` is closed, or ctx.Err() once `ctx` is
+// done. Deliveries are handled one at a time, and are acknowledged or not by
+// `f`, as it sees fit.
+func Consume(ctx context.Context, deliveries <-chan amqp.Delivery, f func(d *amqp.Delivery)) error {
+	for {
+		select {
+		case d, ok := <-deliveries:
+			if !ok {
+				return nil
+			}
+			glc.WithContext(context.WithValue(ctx, deliveryKey{}, &d), func() {
+				f(&d)
+			})
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
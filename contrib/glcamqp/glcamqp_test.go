@@ -0,0 +1,57 @@
+package glcamqp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type key struct{}
+
+func TestConsume(t *testing.T) {
+	deliveries := make(chan amqp.Delivery, 2)
+	deliveries <- amqp.Delivery{Exchange: "ex", RoutingKey: "a", DeliveryTag: 1}
+	deliveries <- amqp.Delivery{Exchange: "ex", RoutingKey: "b", DeliveryTag: 2}
+	close(deliveries)
+
+	ctx := context.WithValue(context.Background(), key{}, "consumer")
+	var keys []string
+	err := Consume(ctx, deliveries, func(d *amqp.Delivery) {
+		bound := glc.GetContext()
+		if bound.Value(key{}) != "consumer" {
+			t.Errorf("delivery %d: context isn't derived from Consume's", d.DeliveryTag)
+		}
+		if got, ok := DeliveryFrom(bound); !ok || got != d {
+			t.Errorf("delivery %d: DeliveryFrom gave %v, %v", d.DeliveryTag, got, ok)
+		}
+		keys = append(keys, d.RoutingKey)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("handled %v, want [a b]", keys)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the delivery")
+	}
+}
+
+func TestConsumeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	deliveries := make(chan amqp.Delivery, 1)
+	deliveries <- amqp.Delivery{DeliveryTag: 1}
+	err := Consume(ctx, deliveries, func(d *amqp.Delivery) {
+		// Shutting down while a delivery is handled cancels its
+		// context.
+		cancel()
+		if glc.GetContext().Err() == nil {
+			t.Error("delivery's context wasn't cancelled")
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/knusbaum/glc/contrib/glcamqp
+
+go 1.19
+
+require (
+	github.com/knusbaum/glc v0.0.0-00010101000000-000000000000
+	github.com/rabbitmq/amqp091-go v1.15.0
+)
+
+replace github.com/knusbaum/glc => ../..
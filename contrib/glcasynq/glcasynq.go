@@ -0,0 +1,36 @@
+// Package glcasynq binds the contexts of asynq tasks with glc, so that code
+// processing a task can get at the task's context with glc.GetContext.
+//
+// glcasynq is a module of its own, so that glc doesn't depend on asynq.
+package glcasynq
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/knusbaum/glc"
+)
+
+type taskKey struct{}
+
+// TaskFrom returns the task stored in `ctx` by Middleware, if any.
+func TaskFrom(ctx context.Context) (*asynq.Task, bool) {
+	t, ok := ctx.Value(taskKey{}).(*asynq.Task)
+	return t, ok
+}
+
+// Middleware is an asynq middleware, for ServeMux.Use, which calls the next
+// handler with the task's context, carrying the task, bound by
+// `glc.WithContext`. asynq's context already carries the task's ID, queue and
+// retry counts, which asynq.GetTaskID and friends read from the bound context
+// as from any other, and has the task's deadline.
+//
+// As with any binding, goroutines started by the handler don't see it.
+func Middleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) (err error) {
+		glc.WithContext(context.WithValue(ctx, taskKey{}, t), func() {
+			err = next.ProcessTask(ctx, t)
+		})
+		return err
+	})
+}
@@ -0,0 +1,39 @@
+package glcasynq
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.WithValue(context.Background(), key{}, "task")
+	task := asynq.NewTask("email:send", nil)
+	errRetry := errors.New("retry")
+
+	var got context.Context
+	mux := asynq.NewServeMux()
+	mux.Use(Middleware)
+	mux.HandleFunc("email:send", func(ctx context.Context, t *asynq.Task) error {
+		got = glc.GetContext()
+		return errRetry
+	})
+	if err := mux.ProcessTask(ctx, task); err != errRetry {
+		t.Errorf("got error %v, want %v", err, errRetry)
+	}
+
+	if got == nil || got.Value(key{}) != "task" {
+		t.Fatalf("got context %v, want the task's", got)
+	}
+	if gt, ok := TaskFrom(got); !ok || gt != task {
+		t.Errorf("TaskFrom gave %v, %v, want %v", gt, ok, task)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the task")
+	}
+}
@@ -0,0 +1,61 @@
+// Package glccron runs robfig/cron jobs with a context for each run bound by
+// glc.WithContext, so that scheduled jobs get the same dynamic scoping as
+// request handlers.
+//
+// glccron is a module of its own, so that glc doesn't depend on robfig/cron.
+package glccron
+
+import (
+	"context"
+	"time"
+
+	"github.com/knusbaum/glc"
+	"github.com/robfig/cron/v3"
+)
+
+// RunInfo describes the run a job is making.
+type RunInfo struct {
+	// Name is the name the job was given.
+	Name string
+	// Start is when the run started.
+	Start time.Time
+}
+
+type runInfoKey struct{}
+
+// RunInfoFrom returns the RunInfo stored in `ctx` by a job wrapped by Wrap, if
+// any.
+func RunInfoFrom(ctx context.Context) (RunInfo, bool) {
+	ri, ok := ctx.Value(runInfoKey{}).(RunInfo)
+	return ri, ok
+}
+
+// Wrap returns a cron.JobWrapper which runs jobs with a context for each run
+// bound by `glc.WithContext`. The context is derived from `ctx`, so cancelling
+// `ctx` cancels runs in progress, and carries a RunInfo naming the job
+// `name`. If `timeout` is more than zero, the context's deadline is `timeout`
+// after the run starts.
+//
+// The wrapper only sets up the context. It's up to the job to give up when the
+// context is done.
+func Wrap(ctx context.Context, name string, timeout time.Duration) cron.JobWrapper {
+	return func(j cron.Job) cron.Job {
+		return cron.FuncJob(func() {
+			start := time.Now()
+			runCtx := context.WithValue(ctx, runInfoKey{}, RunInfo{Name: name, Start: start})
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				runCtx, cancel = context.WithDeadline(runCtx, start.Add(timeout))
+				defer cancel()
+			}
+			glc.WithContext(runCtx, j.Run)
+		})
+	}
+}
+
+// Job returns a job which runs `f` as Wrap's wrapper runs jobs.
+//
+//	c.AddJob("@hourly", glccron.Job(ctx, "cleanup", 10*time.Minute, cleanup))
+func Job(ctx context.Context, name string, timeout time.Duration, f func()) cron.Job {
+	return Wrap(ctx, name, timeout)(cron.FuncJob(f))
+}
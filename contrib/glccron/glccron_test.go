@@ -0,0 +1,61 @@
+package glccron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/knusbaum/glc"
+	"github.com/robfig/cron/v3"
+)
+
+type key struct{}
+
+func TestJob(t *testing.T) {
+	ctx := context.WithValue(context.Background(), key{}, "base")
+	var got context.Context
+	before := time.Now()
+	Job(ctx, "cleanup", time.Minute, func() {
+		got = glc.GetContext()
+		if got.Err() != nil {
+			t.Errorf("context done during the run: %v", got.Err())
+		}
+	}).Run()
+
+	if got == nil {
+		t.Fatal("nothing bound during the run")
+	}
+	if got.Value(key{}) != "base" {
+		t.Error("run's context isn't derived from the one given")
+	}
+	ri, ok := RunInfoFrom(got)
+	if !ok || ri.Name != "cleanup" || ri.Start.Before(before) {
+		t.Errorf("got RunInfo %+v, %v, want cleanup started after %v", ri, ok, before)
+	}
+	if d, ok := got.Deadline(); !ok || !d.Equal(ri.Start.Add(time.Minute)) {
+		t.Errorf("got deadline %v, %v, want %v", d, ok, ri.Start.Add(time.Minute))
+	}
+	if got.Err() == nil {
+		t.Error("run's context outlived the run")
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the run")
+	}
+}
+
+func TestWrapNoTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var got context.Context
+	job := cron.NewChain(Wrap(ctx, "sync", 0)).Then(cron.FuncJob(func() {
+		got = glc.GetContext()
+	}))
+	job.Run()
+	if _, ok := got.Deadline(); ok {
+		t.Error("got a deadline with no timeout")
+	}
+	cancel()
+	if got.Err() == nil {
+		t.Error("cancelling the base context didn't reach the run's")
+	}
+}
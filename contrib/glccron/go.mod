@@ -0,0 +1,10 @@
+module github.com/knusbaum/glc/contrib/glccron
+
+go 1.19
+
+require (
+	github.com/knusbaum/glc v0.0.0-00010101000000-000000000000
+	github.com/robfig/cron/v3 v3.0.1
+)
+
+replace github.com/knusbaum/glc => ../..
@@ -0,0 +1,39 @@
+// Package glcecho binds the contexts of requests served by Echo with glc, so
+// that code serving a request can get at the request's context with
+// glc.GetContext.
+//
+// glcecho is a module of its own, so that glc doesn't depend on Echo.
+package glcecho
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"github.com/labstack/echo/v4"
+)
+
+type echoKey struct{}
+
+// EchoContext returns the echo.Context stored in `ctx` by Middleware, if any.
+// Echo reuses it for another request once the handler returns, so it mustn't
+// be kept past then.
+func EchoContext(ctx context.Context) (echo.Context, bool) {
+	c, ok := ctx.Value(echoKey{}).(echo.Context)
+	return c, ok
+}
+
+// Middleware returns a middleware which calls the next handler with the
+// request's context, carrying the echo.Context, bound by `glc.WithContext`.
+//
+// As with any binding, goroutines started by the handlers don't see it.
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			ctx := context.WithValue(c.Request().Context(), echoKey{}, c)
+			glc.WithContext(ctx, func() {
+				err = next(c)
+			})
+			return err
+		}
+	}
+}
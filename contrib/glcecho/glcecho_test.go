@@ -0,0 +1,43 @@
+package glcecho
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"github.com/labstack/echo/v4"
+)
+
+type key struct{}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(Middleware())
+	var got context.Context
+	var ec echo.Context
+	e.GET("/", func(c echo.Context) error {
+		got = glc.GetContext()
+		ec = c
+		return c.NoContent(http.StatusTeapot)
+	})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req = req.WithContext(context.WithValue(req.Context(), key{}, "request"))
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+
+	if w.Code != http.StatusTeapot {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusTeapot)
+	}
+	if got == nil || got.Value(key{}) != "request" {
+		t.Fatalf("got context %v, want the request's", got)
+	}
+	if c, ok := EchoContext(got); !ok || c != ec {
+		t.Errorf("EchoContext gave %v, %v, want %v", c, ok, ec)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the request")
+	}
+}
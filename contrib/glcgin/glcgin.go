@@ -0,0 +1,32 @@
+// Package glcgin binds the contexts of requests served by Gin with glc, so that
+// code serving a request can get at the request's context with glc.GetContext.
+//
+// glcgin is a module of its own, so that glc doesn't depend on Gin.
+package glcgin
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/knusbaum/glc"
+)
+
+type ginKey struct{}
+
+// GinContext returns the *gin.Context stored in `ctx` by Middleware, if any.
+// Gin reuses it for another request once the handlers return, so it mustn't be
+// kept past then.
+func GinContext(ctx context.Context) (*gin.Context, bool) {
+	c, ok := ctx.Value(ginKey{}).(*gin.Context)
+	return c, ok
+}
+
+// Middleware returns a handler which calls the rest of the handler chain with
+// the request's context, carrying the *gin.Context, bound by `glc.WithContext`.
+//
+// As with any binding, goroutines started by the handlers don't see it.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		glc.WithContext(context.WithValue(c.Request.Context(), ginKey{}, c), c.Next)
+	}
+}
@@ -0,0 +1,58 @@
+package glcgin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Middleware())
+	var got context.Context
+	var gc *gin.Context
+	r.GET("/", func(c *gin.Context) {
+		got = glc.GetContext()
+		gc = c
+	})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req = req.WithContext(context.WithValue(req.Context(), key{}, "request"))
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got == nil || got.Value(key{}) != "request" {
+		t.Fatalf("got context %v, want the request's", got)
+	}
+	if c, ok := GinContext(got); !ok || c != gc {
+		t.Errorf("GinContext gave %p, %v, want %p", c, ok, gc)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the request")
+	}
+}
+
+func TestMiddlewareAbort(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Middleware(), func(c *gin.Context) {
+		if glc.GetContext() == nil {
+			t.Error("nothing bound in a later middleware")
+		}
+		c.AbortWithStatus(http.StatusTeapot)
+	})
+	r.GET("/", func(c *gin.Context) {
+		t.Error("handler ran after abort")
+	})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	if w.Code != http.StatusTeapot {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusTeapot)
+	}
+}
@@ -0,0 +1,53 @@
+// Package glcgorm is a GORM plugin which gives statements made without a
+// context the context bound by glc.WithContext, so that queries made by code
+// with no context to hand still get the deadlines and cancellation of the
+// request they're made for.
+//
+// glcgorm is a module of its own, so that glc doesn't depend on GORM.
+package glcgorm
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"gorm.io/gorm"
+)
+
+// Plugin is a gorm.Plugin. Once it's registered with db.Use, every statement
+// whose context is `context.Background()` or `context.TODO()`, which is what a
+// session has unless it's given one with WithContext, gets the context bound
+// by `glc.WithContext` instead, if there is one. It's set before any other
+// callback runs, so they all see it.
+type Plugin struct{}
+
+// Name returns "glc".
+func (Plugin) Name() string {
+	return "glc"
+}
+
+// Initialize registers the plugin's callbacks with `db`.
+func (Plugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("*").Register("glc:context", setContext),
+		cb.Query().Before("*").Register("glc:context", setContext),
+		cb.Update().Before("*").Register("glc:context", setContext),
+		cb.Delete().Before("*").Register("glc:context", setContext),
+		cb.Row().Before("*").Register("glc:context", setContext),
+		cb.Raw().Before("*").Register("glc:context", setContext),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setContext(db *gorm.DB) {
+	if ctx := db.Statement.Context; ctx != context.Background() && ctx != context.TODO() {
+		return
+	}
+	if ctx := glc.GetContext(); ctx != nil {
+		db.Statement.Context = ctx
+	}
+}
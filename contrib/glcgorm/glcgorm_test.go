@@ -0,0 +1,72 @@
+package glcgorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+)
+
+type key struct{}
+
+type User struct {
+	ID   uint
+	Name string
+}
+
+func TestPlugin(t *testing.T) {
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Use(Plugin{}); err != nil {
+		t.Fatal(err)
+	}
+	var got context.Context
+	record := func(db *gorm.DB) { got = db.Statement.Context }
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Register("test:record", record),
+		cb.Query().Register("test:record", record),
+		cb.Update().Register("test:record", record),
+		cb.Delete().Register("test:record", record),
+		cb.Row().Register("test:record", record),
+		cb.Raw().Register("test:record", record),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	own := context.WithValue(context.Background(), key{}, "own")
+	ops := map[string]func(db *gorm.DB){
+		"create": func(db *gorm.DB) { db.Create(&User{Name: "a"}) },
+		"query":  func(db *gorm.DB) { db.Find(&[]User{}) },
+		"update": func(db *gorm.DB) { db.Model(&User{ID: 1}).Update("name", "b") },
+		"delete": func(db *gorm.DB) { db.Delete(&User{ID: 1}) },
+		"row":    func(db *gorm.DB) { db.Model(&User{}).Select("name").Row() },
+		"raw":    func(db *gorm.DB) { db.Exec("SELECT 1") },
+	}
+	for name, op := range ops {
+		got = nil
+		glc.WithContext(bound, func() { op(db) })
+		if got != bound {
+			t.Errorf("%s: got context %v, want the bound one", name, got)
+		}
+
+		got = nil
+		glc.WithContext(bound, func() { op(db.WithContext(own)) })
+		if got != own {
+			t.Errorf("%s: got context %v, want the session's own", name, got)
+		}
+
+		got = nil
+		op(db)
+		if got != context.Background() {
+			t.Errorf("%s, unbound: got context %v, want Background", name, got)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/knusbaum/glc/contrib/glcgorm
+
+go 1.19
+
+require (
+	github.com/knusbaum/glc v0.0.0-00010101000000-000000000000
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
+
+replace github.com/knusbaum/glc => ../..
@@ -0,0 +1,61 @@
+// Package glcgqlgen binds the contexts of GraphQL operations served by gqlgen
+// with glc, so that resolvers and the helpers they call can get at the
+// context, and whatever it carries, such as dataloaders, with glc.GetContext.
+//
+// glcgqlgen is a module of its own, so that glc doesn't depend on gqlgen.
+package glcgqlgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/knusbaum/glc"
+)
+
+// Extension is a gqlgen handler extension, added to a server with its Use
+// method. It binds the context of each response while it's made, and the
+// context of each field whose resolver is a method or a resolver function
+// while the resolver runs. gqlgen resolves some fields in goroutines of their
+// own, which the binding around the response doesn't reach, so each field gets
+// its own.
+//
+// Fields resolved by reading a struct field run no code of the application's,
+// so they aren't bound.
+type Extension struct{}
+
+var (
+	_ graphql.HandlerExtension    = Extension{}
+	_ graphql.ResponseInterceptor = Extension{}
+	_ graphql.FieldInterceptor    = Extension{}
+)
+
+// ExtensionName returns "GLC".
+func (Extension) ExtensionName() string {
+	return "GLC"
+}
+
+// Validate accepts any schema.
+func (Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse calls `next` with `ctx` bound by `glc.WithContext`.
+func (Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) (resp *graphql.Response) {
+	glc.WithContext(ctx, func() {
+		resp = next(ctx)
+	})
+	return resp
+}
+
+// InterceptField calls `next` with `ctx` bound by `glc.WithContext`, if the
+// field's resolver is a method or a resolver function, and just calls it
+// otherwise.
+func (Extension) InterceptField(ctx context.Context, next graphql.Resolver) (res any, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && !fc.IsMethod && !fc.IsResolver {
+		return next(ctx)
+	}
+	glc.WithContext(ctx, func() {
+		res, err = next(ctx)
+	})
+	return res, err
+}
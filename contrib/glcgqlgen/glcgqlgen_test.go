@@ -0,0 +1,58 @@
+package glcgqlgen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestInterceptResponse(t *testing.T) {
+	ctx := context.WithValue(context.Background(), key{}, "operation")
+	var got context.Context
+	resp := Extension{}.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		got = glc.GetContext()
+		return &graphql.Response{}
+	})
+	if resp == nil {
+		t.Error("lost the response")
+	}
+	if got != ctx {
+		t.Errorf("got context %v, want the operation's", got)
+	}
+}
+
+func TestInterceptField(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		fc    *graphql.FieldContext
+		bound bool
+	}{
+		{"resolver", &graphql.FieldContext{IsResolver: true}, true},
+		{"method", &graphql.FieldContext{IsMethod: true}, true},
+		{"struct field", &graphql.FieldContext{}, false},
+		{"no field context", nil, true},
+	} {
+		ctx := context.WithValue(context.Background(), key{}, test.name)
+		if test.fc != nil {
+			ctx = graphql.WithFieldContext(ctx, test.fc)
+		}
+		var got context.Context
+		res, err := Extension{}.InterceptField(ctx, func(ctx context.Context) (any, error) {
+			got = glc.GetContext()
+			return "value", nil
+		})
+		if res != "value" || err != nil {
+			t.Errorf("%s: got %v, %v, want value, nil", test.name, res, err)
+		}
+		if test.bound && got != ctx {
+			t.Errorf("%s: got context %v, want the field's", test.name, got)
+		}
+		if !test.bound && got != nil {
+			t.Errorf("%s: got context %v, want none", test.name, got)
+		}
+	}
+}
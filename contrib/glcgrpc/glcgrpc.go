@@ -0,0 +1,73 @@
+// Package glcgrpc binds the contexts of gRPC calls with glc, so that code
+// serving an RPC can get at the call's context with glc.GetContext, and gives
+// calls made with no context the bound one.
+//
+// glcgrpc is a module of its own, so that glc doesn't depend on gRPC.
+package glcgrpc
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor returns an interceptor which calls the handler with
+// the call's context bound by `glc.WithContext`.
+//
+// As with any binding, goroutines started by the handler don't see it.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		glc.WithContext(ctx, func() {
+			resp, err = handler(ctx, req)
+		})
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which calls the handler with
+// the stream's context bound by `glc.WithContext`.
+//
+// As with any binding, goroutines started by the handler don't see it. That
+// includes the goroutines some handlers start to receive while they send.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		glc.WithContext(ss.Context(), func() {
+			err = handler(srv, ss)
+		})
+		return err
+	}
+}
+
+// UnaryClientInterceptor returns an interceptor which gives calls made without
+// a context the context bound by `glc.WithContext`. A call is taken to have no
+// context if its context is `context.Background()` or `context.TODO()`.
+//
+// This lets deadlines and cancellation reach clients buried in code that never
+// had a context to give them.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(bound(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is UnaryClientInterceptor for streams. The bound
+// context is the stream's for as long as it lasts, not just while it's being
+// created.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(bound(ctx), desc, cc, method, opts...)
+	}
+}
+
+// bound returns the context bound by glc.WithContext if ctx is
+// context.Background() or context.TODO() and there is one, and ctx otherwise.
+func bound(ctx context.Context) context.Context {
+	if ctx != context.Background() && ctx != context.TODO() {
+		return ctx
+	}
+	if b := glc.GetContext(); b != nil {
+		return b
+	}
+	return ctx
+}
@@ -0,0 +1,103 @@
+package glcgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"google.golang.org/grpc"
+)
+
+type key struct{}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	ctx := context.WithValue(context.Background(), key{}, "call")
+	var got context.Context
+	resp, err := UnaryServerInterceptor()(ctx, "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		got = glc.GetContext()
+		return "resp", nil
+	})
+	if resp != "resp" || err != nil {
+		t.Errorf("got %v, %v, want resp, nil", resp, err)
+	}
+	if got != ctx {
+		t.Errorf("got context %v, want the call's", got)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the call")
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ss := serverStream{ctx: context.WithValue(context.Background(), key{}, "stream")}
+	var got context.Context
+	err := StreamServerInterceptor()(nil, ss, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		got = glc.GetContext()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != ss.ctx {
+		t.Errorf("got context %v, want the stream's", got)
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	var got context.Context
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		got = ctx
+		return nil
+	}
+	call := func(ctx context.Context) context.Context {
+		got = nil
+		if err := UnaryClientInterceptor()(ctx, "/S/M", nil, nil, nil, invoker); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	own := context.WithValue(context.Background(), key{}, "own")
+	glc.WithContext(bound, func() {
+		if got := call(context.Background()); got != bound {
+			t.Errorf("Background: got context %v, want the bound one", got)
+		}
+		if got := call(context.TODO()); got != bound {
+			t.Errorf("TODO: got context %v, want the bound one", got)
+		}
+		if got := call(own); got != own {
+			t.Errorf("got context %v, want the call's own", got)
+		}
+	})
+	// Nothing bound, so the call keeps what it has.
+	if got := call(context.Background()); got != context.Background() {
+		t.Errorf("unbound: got context %v, want Background", got)
+	}
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	var got context.Context
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		got = ctx
+		return nil, nil
+	}
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	glc.WithContext(bound, func() {
+		if _, err := StreamClientInterceptor()(context.Background(), &grpc.StreamDesc{}, nil, "/S/M", streamer); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if got != bound {
+		t.Errorf("got context %v, want the bound one", got)
+	}
+}
@@ -0,0 +1,50 @@
+// Package glclogrus adds fields from the context bound by glc.WithContext to
+// logrus's log entries, so that entries logged by code with no context to hand
+// still say which request they belong to.
+//
+// glclogrus is a module of its own, so that glc doesn't depend on logrus.
+package glclogrus
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"github.com/sirupsen/logrus"
+)
+
+// Hook is a logrus.Hook which adds the fields that Fields extracts from a
+// context to every entry.
+//
+// The context is the entry's own, if it was given one with WithContext, and
+// otherwise the context bound by `glc.WithContext` where the entry is logged.
+// Entries with neither are left alone. Fields already on the entry are kept
+// over those Fields returns.
+//
+//	logrus.AddHook(glclogrus.Hook{Fields: func(ctx context.Context) logrus.Fields {
+//		return logrus.Fields{"request": requestID(ctx)}
+//	}})
+type Hook struct {
+	Fields func(ctx context.Context) logrus.Fields
+}
+
+// Levels returns all the levels.
+func (h Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire adds the fields to `e`.
+func (h Hook) Fire(e *logrus.Entry) error {
+	ctx := e.Context
+	if ctx == nil {
+		ctx = glc.GetContext()
+	}
+	if ctx == nil {
+		return nil
+	}
+	for k, v := range h.Fields(ctx) {
+		if _, ok := e.Data[k]; !ok {
+			e.Data[k] = v
+		}
+	}
+	return nil
+}
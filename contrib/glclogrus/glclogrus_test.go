@@ -0,0 +1,64 @@
+package glclogrus
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+type key struct{}
+
+func requestFields(ctx context.Context) logrus.Fields {
+	if v, ok := ctx.Value(key{}).(string); ok {
+		return logrus.Fields{"request": v}
+	}
+	return nil
+}
+
+func TestHook(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	logger.AddHook(Hook{Fields: requestFields})
+	logs := test.NewLocal(logger)
+
+	logger.Info("unbound")
+	glc.WithContext(context.WithValue(context.Background(), key{}, "outer"), func() {
+		logger.Info("outer")
+		glc.WithContext(context.WithValue(glc.GetContext(), key{}, "inner"), func() {
+			logger.Info("inner")
+		})
+		logger.WithField("request", "own").Info("own field")
+		logger.WithContext(context.WithValue(context.Background(), key{}, "entry")).Info("own context")
+	})
+
+	want := []struct {
+		msg, request string
+	}{
+		{"unbound", ""},
+		{"outer", "outer"},
+		{"inner", "inner"},
+		{"own field", "own"},
+		{"own context", "entry"},
+	}
+	entries := logs.AllEntries()
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i, w := range want {
+		e := entries[i]
+		got, ok := e.Data["request"]
+		if w.request == "" {
+			if ok {
+				t.Errorf("%s: got request %v, want none", e.Message, got)
+			}
+			continue
+		}
+		if got != w.request {
+			t.Errorf("%s: got request %v, want %s", e.Message, got, w.request)
+		}
+	}
+}
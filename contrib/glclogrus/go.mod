@@ -0,0 +1,12 @@
+module github.com/knusbaum/glc/contrib/glclogrus
+
+go 1.23
+
+require (
+	github.com/knusbaum/glc v0.0.0-00010101000000-000000000000
+	github.com/sirupsen/logrus v1.10.2
+)
+
+require golang.org/x/sys v0.13.0 // indirect
+
+replace github.com/knusbaum/glc => ../..
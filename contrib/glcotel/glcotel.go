@@ -0,0 +1,72 @@
+// Package glcotel keeps OpenTelemetry spans and baggage in the context bound by
+// glc.WithContext, so that code with no context to hand can still find the
+// current span, start spans parented by it, and read and add to the baggage.
+//
+// glcotel is a module of its own, so that glc doesn't depend on OpenTelemetry.
+package glcotel
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// bound returns the context bound by glc.WithContext, or context.Background()
+// if there isn't one.
+func bound() context.Context {
+	if ctx := glc.GetContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// WithSpan calls `f` with the bound context, carrying `span`, bound by
+// `glc.WithContext`. If nothing is bound, the span is carried by
+// `context.Background()`.
+func WithSpan(span trace.Span, f func()) {
+	glc.WithContext(trace.ContextWithSpan(bound(), span), f)
+}
+
+// Span returns the span carried by the bound context. As with
+// trace.SpanFromContext, if there is none, it returns a span that records
+// nothing.
+func Span() trace.Span {
+	return trace.SpanFromContext(bound())
+}
+
+// Start starts a span named `name` with `tracer`, as a child of the span carried
+// by the bound context, and calls `f` with the span's context bound. The span
+// ends when `f` returns, or panics.
+func Start(tracer trace.Tracer, name string, f func(), opts ...trace.SpanStartOption) {
+	ctx, span := tracer.Start(bound(), name, opts...)
+	defer span.End()
+	glc.WithContext(ctx, f)
+}
+
+// Baggage returns the baggage carried by the bound context, which is empty if
+// there is none.
+func Baggage() baggage.Baggage {
+	return baggage.FromContext(bound())
+}
+
+// WithBaggageMember calls `f` with the bound context bound again by
+// `glc.WithContext`, carrying the bound baggage with the member `key`=`value`
+// added. A member already called `key` is replaced. `value` is the value as
+// it is, not percent-encoded.
+//
+// If `key` is empty, or `value` can't go in baggage, WithBaggageMember returns
+// the error without calling `f`.
+func WithBaggageMember(key, value string, f func()) error {
+	m, err := baggage.NewMemberRaw(key, value)
+	if err != nil {
+		return err
+	}
+	b, err := Baggage().SetMember(m)
+	if err != nil {
+		return err
+	}
+	glc.WithContext(baggage.ContextWithBaggage(bound(), b), f)
+	return nil
+}
@@ -0,0 +1,98 @@
+package glcotel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type key struct{}
+
+func TestWithSpan(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+
+	if Span().SpanContext().IsValid() {
+		t.Error("got a span with nothing bound")
+	}
+	ctx := context.WithValue(context.Background(), key{}, "bound")
+	glc.WithContext(ctx, func() {
+		WithSpan(span, func() {
+			if Span() != span {
+				t.Errorf("got span %v, want %v", Span(), span)
+			}
+			if glc.GetContext().Value(key{}) != "bound" {
+				t.Error("lost the bound context's values")
+			}
+		})
+		if Span().SpanContext().IsValid() {
+			t.Error("span outlived WithSpan")
+		}
+	})
+}
+
+func TestStart(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)).Tracer("test")
+
+	var outer, inner trace.SpanContext
+	Start(tracer, "outer", func() {
+		outer = Span().SpanContext()
+		Start(tracer, "inner", func() {
+			inner = Span().SpanContext()
+		})
+	})
+
+	ended := rec.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("got %d ended spans, want 2", len(ended))
+	}
+	if ended[0].Name() != "inner" || ended[1].Name() != "outer" {
+		t.Errorf("got spans %s, %s, want inner, outer", ended[0].Name(), ended[1].Name())
+	}
+	if ended[0].Parent().SpanID() != outer.SpanID() {
+		t.Errorf("inner's parent is %v, want %v", ended[0].Parent().SpanID(), outer.SpanID())
+	}
+	if ended[0].SpanContext().SpanID() != inner.SpanID() {
+		t.Error("Span didn't return the span Start started")
+	}
+	if ended[1].Parent().IsValid() {
+		t.Errorf("outer has parent %v, want none", ended[1].Parent())
+	}
+}
+
+func TestBaggage(t *testing.T) {
+	if Baggage().Len() != 0 {
+		t.Errorf("got baggage %v with nothing bound", Baggage())
+	}
+	err := WithBaggageMember("user", "alice smith", func() {
+		err := WithBaggageMember("tenant", "acme", func() {
+			b := Baggage()
+			if b.Member("user").Value() != "alice smith" || b.Member("tenant").Value() != "acme" {
+				t.Errorf("got baggage %v, want user and tenant", b)
+			}
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		if b := Baggage(); b.Len() != 1 {
+			t.Errorf("inner member outlived its scope: %v", b)
+		}
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	called := false
+	if err := WithBaggageMember("", "v", func() { called = true }); err == nil {
+		t.Error("no error for an empty key")
+	}
+	if called {
+		t.Error("f called despite the error")
+	}
+}
@@ -0,0 +1,112 @@
+// Package glcprom exports glc's counters to Prometheus: the ones
+// glc.PublishExpvar publishes, and the decode histograms kept while
+// glc.EnableProfiling is on.
+//
+// glcprom is a module of its own, so that glc doesn't depend on the Prometheus
+// client.
+package glcprom
+
+import (
+	"github.com/knusbaum/glc"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	activeBindings = prometheus.NewDesc("glc_active_bindings",
+		"WithContext scopes live now.", nil, nil)
+	maxActiveBindings = prometheus.NewDesc("glc_max_active_bindings",
+		"Most WithContext scopes live at once since the program started.", nil, nil)
+	reclaimedBindingSlots = prometheus.NewDesc("glc_reclaimed_binding_slots_total",
+		"Entries' worth of memory given back by shrinking the table of bindings.", nil, nil)
+	droppedBindings = prometheus.NewDesc("glc_dropped_bindings_total",
+		"WithContext scopes run with no context because the binding limit was reached.", nil, nil)
+	leaksReported = prometheus.NewDesc("glc_leaks_reported_total",
+		"Bindings reported by WatchLeaks watchdogs.", nil, nil)
+	decodeFailures = prometheus.NewDesc("glc_decode_failures_total",
+		"Decodes that failed, by the way they failed.", []string{"kind"}, nil)
+	decodeLatency = prometheus.NewDesc("glc_decode_latency_seconds",
+		"How long decodes took, while profiling is enabled.", nil, nil)
+	decodeFrames = prometheus.NewDesc("glc_decode_frames",
+		"How many stack frames decodes looked at, while profiling is enabled.", nil, nil)
+)
+
+var failureKinds = []glc.DecodeFailureKind{
+	glc.NoBinding,
+	glc.UnknownID,
+	glc.DecoderMismatch,
+	glc.TruncatedStack,
+	glc.Dropped,
+}
+
+type collector struct{}
+
+// Collector returns a prometheus.Collector for glc's counters. Register it once:
+//
+//	prometheus.MustRegister(glcprom.Collector())
+//
+// The decode histograms are only collected once glc.EnableProfiling has been
+// called. glc keeps them in power-of-two buckets, and they're exported with
+// those buckets. It keeps no sum of the latencies, so the latency histogram's
+// sum is estimated, from the middle of each bucket.
+func Collector() prometheus.Collector {
+	return collector{}
+}
+
+func (collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		activeBindings,
+		maxActiveBindings,
+		reclaimedBindingSlots,
+		droppedBindings,
+		leaksReported,
+		decodeFailures,
+		decodeLatency,
+		decodeFrames,
+	} {
+		ch <- d
+	}
+}
+
+func (collector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(activeBindings, prometheus.GaugeValue, float64(glc.ActiveBindings()))
+	ch <- prometheus.MustNewConstMetric(maxActiveBindings, prometheus.GaugeValue, float64(glc.MaxActiveBindings()))
+	ch <- prometheus.MustNewConstMetric(reclaimedBindingSlots, prometheus.CounterValue, float64(glc.ReclaimedBindingSlots()))
+	ch <- prometheus.MustNewConstMetric(droppedBindings, prometheus.CounterValue, float64(glc.DroppedBindings()))
+	ch <- prometheus.MustNewConstMetric(leaksReported, prometheus.CounterValue, float64(glc.LeaksReported()))
+	for _, k := range failureKinds {
+		ch <- prometheus.MustNewConstMetric(decodeFailures, prometheus.CounterValue, float64(glc.DecodeFailures(k)), k.String())
+	}
+
+	p := glc.Profile()
+	if p.Decodes == 0 {
+		return
+	}
+	ch <- histogram(decodeLatency, p.Latency[:], 1e-9)
+	ch <- histogram(decodeFrames, p.Frames[:], 1)
+}
+
+// histogram returns the histogram for the power-of-two buckets `counts`, in
+// which counts[0] counts zeros, counts[i] counts values of at least 1<<(i-1)
+// but less than 1<<i, and the last bucket also counts everything bigger. The
+// values are multiplied by `unit`. The sum is estimated by taking each value to
+// be in the middle of its bucket, or at the bottom of the last.
+func histogram(desc *prometheus.Desc, counts []int64, unit float64) prometheus.Metric {
+	buckets := make(map[float64]uint64, len(counts)-1)
+	var count uint64
+	var sum float64
+	for i, n := range counts {
+		count += uint64(n)
+		if i == 0 {
+			buckets[0] = count
+			continue
+		}
+		lower := float64(uint64(1) << (i - 1))
+		if i == len(counts)-1 {
+			sum += float64(n) * lower * unit
+			break
+		}
+		buckets[(2*lower-1)*unit] = count
+		sum += float64(n) * (lower + (lower-1)/2) * unit
+	}
+	return prometheus.MustNewConstHistogram(desc, count, sum, buckets)
+}
@@ -0,0 +1,92 @@
+package glcprom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// gather returns the metrics collected by Collector, by name.
+func gather(t *testing.T) map[string][]*dto.Metric {
+	t.Helper()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(Collector())
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := make(map[string][]*dto.Metric)
+	for _, f := range families {
+		m[f.GetName()] = f.GetMetric()
+	}
+	return m
+}
+
+func TestCollector(t *testing.T) {
+	glc.EnableProfiling()
+	defer glc.DisableProfiling()
+
+	glc.WithContext(context.Background(), func() {
+		glc.GetContext()
+		got := gather(t)["glc_active_bindings"]
+		if len(got) != 1 || got[0].GetGauge().GetValue() < 1 {
+			t.Errorf("got active bindings %v inside a scope, want at least 1", got)
+		}
+	})
+	glc.GetContext()
+
+	got := gather(t)
+	want := map[string]int{
+		"glc_active_bindings":               1,
+		"glc_max_active_bindings":           1,
+		"glc_reclaimed_binding_slots_total": 1,
+		"glc_dropped_bindings_total":        1,
+		"glc_leaks_reported_total":          1,
+		"glc_decode_failures_total":         len(failureKinds),
+		"glc_decode_latency_seconds":        1,
+		"glc_decode_frames":                 1,
+	}
+	for name, n := range want {
+		if len(got[name]) != n {
+			t.Errorf("got %d metrics named %s, want %d", len(got[name]), name, n)
+		}
+	}
+
+	for _, m := range got["glc_decode_failures_total"] {
+		if m.GetLabel()[0].GetValue() == glc.NoBinding.String() && m.GetCounter().GetValue() < 1 {
+			t.Errorf("no NoBinding failures counted after calling GetContext outside a scope")
+		}
+	}
+	if h := got["glc_decode_frames"][0].GetHistogram(); h.GetSampleCount() < 2 {
+		t.Errorf("got %d decodes, want at least 2", h.GetSampleCount())
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	// A 0, two 1s, a 2 and a 100, in buckets of which the last starts at 4.
+	var m dto.Metric
+	if err := histogram(decodeFrames, []int64{1, 2, 1, 1}, 1).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	h := m.GetHistogram()
+	if h.GetSampleCount() != 5 {
+		t.Errorf("got count %d, want 5", h.GetSampleCount())
+	}
+	want := map[float64]uint64{0: 1, 1: 3, 3: 4}
+	for _, b := range h.GetBucket() {
+		if n, ok := want[b.GetUpperBound()]; !ok || b.GetCumulativeCount() != n {
+			t.Errorf("got %d at le=%v, want %d", b.GetCumulativeCount(), b.GetUpperBound(), n)
+		}
+		delete(want, b.GetUpperBound())
+	}
+	if len(want) != 0 {
+		t.Errorf("missing buckets %v", want)
+	}
+	// The 2 is estimated to be 2.5, and the 100 to be 4.
+	if h.GetSampleSum() != 8.5 {
+		t.Errorf("got sum %v, want 8.5", h.GetSampleSum())
+	}
+}
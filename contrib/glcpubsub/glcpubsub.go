@@ -0,0 +1,32 @@
+// Package glcpubsub binds the contexts of Google Cloud Pub/Sub messages with
+// glc, so that code processing a message can get at the message's context with
+// glc.GetContext.
+//
+// glcpubsub is a module of its own, so that glc doesn't depend on the Pub/Sub
+// client.
+package glcpubsub
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/knusbaum/glc"
+)
+
+// Handler returns a callback for Subscriber.Receive which calls `f` with the
+// message's context bound by `glc.WithContext`. The Pub/Sub client cancels that
+// context when Receive's context is done or the service fails, so code
+// processing the message learns it should give up without being passed the
+// context.
+//
+//	err := sub.Receive(ctx, glcpubsub.Handler(func(msg *pubsub.Message) {
+//		process(msg.Data)
+//		msg.Ack()
+//	}))
+func Handler(f func(msg *pubsub.Message)) func(ctx context.Context, msg *pubsub.Message) {
+	return func(ctx context.Context, msg *pubsub.Message) {
+		glc.WithContext(ctx, func() {
+			f(msg)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package glcpubsub
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestHandler(t *testing.T) {
+	ctx := context.WithValue(context.Background(), key{}, "message")
+	msg := &pubsub.Message{ID: "1"}
+	var got context.Context
+	var gotMsg *pubsub.Message
+	Handler(func(m *pubsub.Message) {
+		got = glc.GetContext()
+		gotMsg = m
+	})(ctx, msg)
+	if got != ctx {
+		t.Errorf("got context %v, want the message's", got)
+	}
+	if gotMsg != msg {
+		t.Errorf("got message %v, want %v", gotMsg, msg)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the message")
+	}
+}
@@ -0,0 +1,57 @@
+// Package glcredis is a go-redis hook which gives commands made without a
+// context the context bound by glc.WithContext, so that Redis calls made by
+// code with no context to hand still get the deadlines and cancellation of the
+// request they're made for.
+//
+// glcredis is a module of its own, so that glc doesn't depend on go-redis.
+package glcredis
+
+import (
+	"context"
+	"net"
+
+	"github.com/knusbaum/glc"
+	"github.com/redis/go-redis/v9"
+)
+
+// Hook is a redis.Hook. Once it's added to a client with AddHook, commands,
+// pipelines and dials whose context is `context.Background()` or
+// `context.TODO()` get the context bound by `glc.WithContext` instead, if there
+// is one.
+//
+// Add it before other hooks, so that they see the bound context too.
+type Hook struct{}
+
+// DialHook substitutes the bound context for dials.
+func (Hook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(bound(ctx), network, addr)
+	}
+}
+
+// ProcessHook substitutes the bound context for commands.
+func (Hook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		return next(bound(ctx), cmd)
+	}
+}
+
+// ProcessPipelineHook substitutes the bound context for pipelines and
+// transactions.
+func (Hook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		return next(bound(ctx), cmds)
+	}
+}
+
+// bound returns the context bound by glc.WithContext if ctx is
+// context.Background() or context.TODO() and there is one, and ctx otherwise.
+func bound(ctx context.Context) context.Context {
+	if ctx != context.Background() && ctx != context.TODO() {
+		return ctx
+	}
+	if b := glc.GetContext(); b != nil {
+		return b
+	}
+	return ctx
+}
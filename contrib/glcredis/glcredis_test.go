@@ -0,0 +1,91 @@
+package glcredis
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"github.com/redis/go-redis/v9"
+)
+
+type key struct{}
+
+var errStop = errors.New("stop")
+
+// recorder is a hook which records the contexts commands are given, and stops
+// them there, so that nothing needs a server.
+type recorder struct {
+	process, pipeline context.Context
+}
+
+func (r *recorder) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (r *recorder) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		r.process = ctx
+		return errStop
+	}
+}
+
+func (r *recorder) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		r.pipeline = ctx
+		return errStop
+	}
+}
+
+func TestHook(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:0"})
+	defer client.Close()
+	client.AddHook(Hook{})
+	var rec recorder
+	client.AddHook(&rec)
+
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	own := context.WithValue(context.Background(), key{}, "own")
+	glc.WithContext(bound, func() {
+		client.Get(context.Background(), "k")
+		if rec.process != bound {
+			t.Errorf("Background: got context %v, want the bound one", rec.process)
+		}
+		client.Get(context.TODO(), "k")
+		if rec.process != bound {
+			t.Errorf("TODO: got context %v, want the bound one", rec.process)
+		}
+		client.Get(own, "k")
+		if rec.process != own {
+			t.Errorf("got context %v, want the command's own", rec.process)
+		}
+
+		client.Pipelined(context.Background(), func(p redis.Pipeliner) error {
+			p.Get(context.Background(), "k")
+			return nil
+		})
+		if rec.pipeline != bound {
+			t.Errorf("pipeline: got context %v, want the bound one", rec.pipeline)
+		}
+	})
+	client.Get(context.Background(), "k")
+	if rec.process != context.Background() {
+		t.Errorf("unbound: got context %v, want Background", rec.process)
+	}
+}
+
+func TestDialHook(t *testing.T) {
+	var got context.Context
+	dial := Hook{}.DialHook(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		got = ctx
+		return nil, errStop
+	})
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	glc.WithContext(bound, func() {
+		dial(context.Background(), "tcp", "localhost:0")
+	})
+	if got != bound {
+		t.Errorf("got context %v, want the bound one", got)
+	}
+}
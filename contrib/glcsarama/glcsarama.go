@@ -0,0 +1,72 @@
+// Package glcsarama consumes Kafka messages with sarama, with a context for
+// each message bound by glc.WithContext around its handling, so that code
+// processing the message can get at it with glc.GetContext.
+//
+// glcsarama is a module of its own, so that glc doesn't depend on sarama.
+package glcsarama
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/knusbaum/glc"
+)
+
+type messageKey struct{}
+
+// MessageFrom returns the message stored in `ctx` by a Handler, if any.
+func MessageFrom(ctx context.Context) (*sarama.ConsumerMessage, bool) {
+	msg, ok := ctx.Value(messageKey{}).(*sarama.ConsumerMessage)
+	return msg, ok
+}
+
+// Handler is a sarama.ConsumerGroupHandler which calls Message for each message
+// claimed, with a context for the message bound by `glc.WithContext`. The
+// context is the session's, so it's cancelled when the session ends, and it
+// carries the message, which MessageFrom returns.
+//
+// Setup and Cleanup do nothing. A handler that needs them can embed Handler in
+// a type of its own that defines them.
+type Handler struct {
+	// Message handles a message. If it returns nil, the message is marked
+	// as consumed. If it returns an error, ConsumeClaim returns it without
+	// marking the message or handling any more.
+	Message func(msg *sarama.ConsumerMessage) error
+	// Context, if it's not nil, is called with the context made for each
+	// message, and returns the one to bind instead. Use it to add values
+	// from the message, such as trace headers.
+	Context func(ctx context.Context, msg *sarama.ConsumerMessage) context.Context
+}
+
+// Setup does nothing.
+func (h Handler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup does nothing.
+func (h Handler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim calls Message for each of the claim's messages in turn, until
+// the claim's messages run out, the session ends, or Message returns an error.
+func (h Handler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			ctx := context.WithValue(sess.Context(), messageKey{}, msg)
+			if h.Context != nil {
+				ctx = h.Context(ctx, msg)
+			}
+			var err error
+			glc.WithContext(ctx, func() {
+				err = h.Message(msg)
+			})
+			if err != nil {
+				return err
+			}
+			sess.MarkMessage(msg, "")
+		case <-sess.Context().Done():
+			return nil
+		}
+	}
+}
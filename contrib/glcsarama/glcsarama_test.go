@@ -0,0 +1,111 @@
+package glcsarama
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/knusbaum/glc"
+)
+
+type (
+	key       struct{}
+	offsetKey struct{}
+)
+
+type session struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *session) Context() context.Context {
+	return s.ctx
+}
+
+func (s *session) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type claim struct {
+	sarama.ConsumerGroupClaim
+	msgs chan *sarama.ConsumerMessage
+}
+
+func (c claim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.msgs
+}
+
+func newClaim(n int) claim {
+	c := claim{msgs: make(chan *sarama.ConsumerMessage, n)}
+	for i := 0; i < n; i++ {
+		c.msgs <- &sarama.ConsumerMessage{Topic: "t", Partition: 1, Offset: int64(i)}
+	}
+	close(c.msgs)
+	return c
+}
+
+func TestHandler(t *testing.T) {
+	sess := &session{ctx: context.WithValue(context.Background(), key{}, "session")}
+	var offsets []int64
+	h := Handler{
+		Message: func(msg *sarama.ConsumerMessage) error {
+			ctx := glc.GetContext()
+			if ctx.Value(key{}) != "session" {
+				t.Errorf("message %d: context isn't the session's", msg.Offset)
+			}
+			if got, ok := MessageFrom(ctx); !ok || got != msg {
+				t.Errorf("message %d: MessageFrom gave %v, %v", msg.Offset, got, ok)
+			}
+			if ctx.Value(offsetKey{}) != msg.Offset {
+				t.Errorf("message %d: Context wasn't applied", msg.Offset)
+			}
+			offsets = append(offsets, msg.Offset)
+			return nil
+		},
+		Context: func(ctx context.Context, msg *sarama.ConsumerMessage) context.Context {
+			return context.WithValue(ctx, offsetKey{}, msg.Offset)
+		},
+	}
+	if err := h.ConsumeClaim(sess, newClaim(3)); err != nil {
+		t.Fatal(err)
+	}
+	if len(offsets) != 3 || len(sess.marked) != 3 {
+		t.Errorf("handled %v and marked %v, want 3 of each", offsets, sess.marked)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the message")
+	}
+}
+
+func TestHandlerError(t *testing.T) {
+	sess := &session{ctx: context.Background()}
+	errBad := errors.New("bad message")
+	h := Handler{Message: func(msg *sarama.ConsumerMessage) error {
+		if msg.Offset == 1 {
+			return errBad
+		}
+		return nil
+	}}
+	if err := h.ConsumeClaim(sess, newClaim(3)); err != errBad {
+		t.Errorf("got error %v, want %v", err, errBad)
+	}
+	if len(sess.marked) != 1 || sess.marked[0] != 0 {
+		t.Errorf("marked %v, want just 0", sess.marked)
+	}
+}
+
+func TestHandlerSessionEnds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sess := &session{ctx: ctx}
+	c := claim{msgs: make(chan *sarama.ConsumerMessage)}
+	h := Handler{Message: func(msg *sarama.ConsumerMessage) error {
+		t.Error("handled a message after the session ended")
+		return nil
+	}}
+	if err := h.ConsumeClaim(sess, c); err != nil {
+		t.Error(err)
+	}
+}
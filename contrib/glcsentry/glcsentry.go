@@ -0,0 +1,66 @@
+// Package glcsentry keeps a Sentry hub in the context bound by glc.WithContext,
+// so that errors and panics reported by code with no context to hand still go
+// to the hub, and carry the scope, of the request they belong to.
+//
+// glcsentry is a module of its own, so that glc doesn't depend on sentry-go.
+package glcsentry
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/knusbaum/glc"
+)
+
+// bound returns the context bound by glc.WithContext, or context.Background()
+// if there isn't one.
+func bound() context.Context {
+	if ctx := glc.GetContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// WithHub calls `f` with the bound context, carrying `hub`, bound by
+// `glc.WithContext`. If nothing is bound, the hub is carried by
+// `context.Background()`.
+//
+// Requests served with sentry-go's own HTTP middleware already have a hub in
+// their contexts, so handlers wrapped by it and then by glchttp.Handler have
+// the hub bound without calling WithHub.
+func WithHub(hub *sentry.Hub, f func()) {
+	glc.WithContext(sentry.SetHubOnContext(bound(), hub), f)
+}
+
+// Hub returns the hub carried by the bound context, or sentry.CurrentHub() if
+// there is none.
+func Hub() *sentry.Hub {
+	if hub := sentry.GetHubFromContext(bound()); hub != nil {
+		return hub
+	}
+	return sentry.CurrentHub()
+}
+
+// Recovered calls `f`, and if it panics, reports the panic to the hub Hub
+// returns, and returns the *glc.PanicError that `glc.Recovered` would. It
+// returns nil if `f` returns normally.
+//
+// The report is made in a scope of its own, tagged with the ID of the binding
+// the panic happened under as "glc.binding". The hub is the one bound where
+// Recovered is called, not one bound within `f`.
+func Recovered(f func()) error {
+	err := glc.Recovered(f)
+	if err == nil {
+		return nil
+	}
+	pe := err.(*glc.PanicError)
+	hub := Hub()
+	hub.WithScope(func(scope *sentry.Scope) {
+		if pe.Bound {
+			scope.SetTag("glc.binding", strconv.FormatUint(pe.ID, 10))
+		}
+		hub.RecoverWithContext(bound(), pe.Value)
+	})
+	return err
+}
@@ -0,0 +1,84 @@
+package glcsentry
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/knusbaum/glc"
+)
+
+// newHub returns a hub whose events are appended to *events instead of being
+// sent anywhere.
+func newHub(t *testing.T, events *[]*sentry.Event) *sentry.Hub {
+	t.Helper()
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		BeforeSend: func(e *sentry.Event, hint *sentry.EventHint) *sentry.Event {
+			*events = append(*events, e)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sentry.NewHub(client, sentry.NewScope())
+}
+
+func TestWithHub(t *testing.T) {
+	var events []*sentry.Event
+	hub := newHub(t, &events)
+
+	if Hub() != sentry.CurrentHub() {
+		t.Error("got a hub other than CurrentHub with nothing bound")
+	}
+	WithHub(hub, func() {
+		if Hub() != hub {
+			t.Errorf("got hub %p, want %p", Hub(), hub)
+		}
+		Hub().CaptureMessage("hello")
+	})
+	if len(events) != 1 || events[0].Message != "hello" {
+		t.Errorf("got events %v, want hello", events)
+	}
+}
+
+func TestRecovered(t *testing.T) {
+	var events []*sentry.Event
+	hub := newHub(t, &events)
+	hub.Scope().SetTag("request", "r1")
+
+	var id uint64
+	var err error
+	WithHub(hub, func() {
+		err = Recovered(func() {
+			glc.WithContext(context.Background(), func() {
+				id, _ = glc.BindingID()
+				panic("boom")
+			})
+		})
+	})
+	var pe *glc.PanicError
+	if !errors.As(err, &pe) || pe.Value != "boom" {
+		t.Fatalf("got error %v, want the panic", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	e := events[0]
+	if e.Tags["request"] != "r1" {
+		t.Errorf("event lost the hub's tags: %v", e.Tags)
+	}
+	if e.Tags["glc.binding"] != strconv.FormatUint(id, 10) {
+		t.Errorf("got glc.binding %q, want %d", e.Tags["glc.binding"], id)
+	}
+	hub.CaptureMessage("after")
+	if _, ok := events[1].Tags["glc.binding"]; ok {
+		t.Error("glc.binding tag leaked into the hub's scope")
+	}
+
+	if err := Recovered(func() {}); err != nil {
+		t.Errorf("got %v from a function that didn't panic", err)
+	}
+}
@@ -0,0 +1,15 @@
+module github.com/knusbaum/glc/contrib/glcsentry
+
+go 1.25.0
+
+require (
+	github.com/getsentry/sentry-go v0.49.0
+	github.com/knusbaum/glc v0.0.0-00010101000000-000000000000
+)
+
+require (
+	golang.org/x/sys v0.46.0 // indirect
+	golang.org/x/text v0.39.0 // indirect
+)
+
+replace github.com/knusbaum/glc => ../..
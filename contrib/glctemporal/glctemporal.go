@@ -0,0 +1,39 @@
+// Package glctemporal binds the contexts of Temporal activities with glc, so
+// that code an activity calls can get at the activity's context, for
+// cancellation and heartbeating, with glc.GetContext.
+//
+// glctemporal is a module of its own, so that glc doesn't depend on the
+// Temporal SDK.
+package glctemporal
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"go.temporal.io/sdk/interceptor"
+)
+
+// Interceptor is a worker interceptor, for worker.Options.Interceptors, which
+// runs every activity with its context bound by `glc.WithContext`.
+//
+// Workflows aren't bound. Their code is given a workflow.Context rather than a
+// context.Context, and has to be deterministic, so it has no use for one.
+type Interceptor struct {
+	interceptor.WorkerInterceptorBase
+}
+
+// InterceptActivity wraps `next` to bind the activity's context.
+func (i *Interceptor) InterceptActivity(ctx context.Context, next interceptor.ActivityInboundInterceptor) interceptor.ActivityInboundInterceptor {
+	return &activityInterceptor{ActivityInboundInterceptorBase: interceptor.ActivityInboundInterceptorBase{Next: next}}
+}
+
+type activityInterceptor struct {
+	interceptor.ActivityInboundInterceptorBase
+}
+
+func (a *activityInterceptor) ExecuteActivity(ctx context.Context, in *interceptor.ExecuteActivityInput) (res any, err error) {
+	glc.WithContext(ctx, func() {
+		res, err = a.Next.ExecuteActivity(ctx, in)
+	})
+	return res, err
+}
@@ -0,0 +1,47 @@
+package glctemporal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+)
+
+func TestInterceptor(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		Interceptors: []interceptor.WorkerInterceptor{&Interceptor{}},
+	})
+
+	greet := func(ctx context.Context, name string) (string, error) {
+		bound := glc.GetContext()
+		if bound == nil {
+			t.Error("nothing bound in the activity")
+			return "", nil
+		}
+		// The bound context is the activity's, so the activity API works
+		// from it.
+		if got := activity.GetInfo(bound).ActivityType.Name; got != "greet" {
+			t.Errorf("got activity %q from the bound context, want greet", got)
+		}
+		return "hello " + name, nil
+	}
+	env.RegisterActivityWithOptions(greet, activity.RegisterOptions{Name: "greet"})
+
+	val, err := env.ExecuteActivity("greet", "world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got string
+	if err := val.Get(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got != "hello world" {
+		t.Errorf("got %q, want hello world", got)
+	}
+}
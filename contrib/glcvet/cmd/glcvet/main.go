@@ -0,0 +1,21 @@
+// Command glcvet runs the glcvet analyzer, which reports goroutines started
+// within a glc.WithContext scope that call glc.GetContext, where they can't see
+// the scope's binding.
+//
+// Run it on its own:
+//
+//	glcvet ./...
+//
+// or as a vet tool:
+//
+//	go vet -vettool=$(which glcvet) ./...
+package main
+
+import (
+	"github.com/knusbaum/glc/contrib/glcvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(glcvet.Analyzer)
+}
@@ -0,0 +1,258 @@
+// Package glcvet defines an analyzer which reports goroutines started within a
+// glc.WithContext scope that go on to call glc.GetContext. Bindings don't cross
+// goroutines, so those calls don't see the scope's context.
+//
+//	glc.WithContext(ctx, func() {
+//		go worker() // worker calls glc.GetContext, and gets nil
+//	})
+//
+// The analyzer looks at `go` statements, and at calls to methods called Go or
+// Submit, such as errgroup.Group.Go, written inside a function literal passed
+// to glc.WithContext, glc.WithContextErr, glc.WithSignals or glclocal.With.
+// It follows calls through other packages with facts, so a goroutine that
+// calls GetContext through any number of statically known calls is reported.
+// Calls through interfaces and function values aren't followed, and neither are
+// calls made within a binding of the goroutine's own, which is how such
+// goroutines are fixed.
+//
+// glcvet is a module of its own, so that glc doesn't depend on
+// golang.org/x/tools. The glcvet command runs the analyzer on its own, or as a
+// vet tool:
+//
+//	go vet -vettool=$(which glcvet) ./...
+package glcvet
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	glcPath      = "github.com/knusbaum/glc"
+	glclocalPath = "github.com/knusbaum/glc/glclocal"
+)
+
+// Analyzer reports goroutines started within a glc.WithContext scope which call
+// glc.GetContext.
+var Analyzer = &analysis.Analyzer{
+	Name:      "glcgoroutine",
+	Doc:       "report goroutines started in a glc.WithContext scope that call glc.GetContext, which can't see the scope's binding",
+	Run:       run,
+	FactTypes: []analysis.Fact{new(getsContext)},
+}
+
+// getsContext is the fact that a function calls glc.GetContext, itself or
+// through the functions it calls, outside any binding of its own.
+type getsContext struct{}
+
+func (*getsContext) AFact() {}
+
+func (*getsContext) String() string { return "getsContext" }
+
+// isFunc is whether fn is one of the functions `names` in the package `path`.
+func isFunc(fn *types.Func, path string, names ...string) bool {
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != path {
+		return false
+	}
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return false
+	}
+	for _, name := range names {
+		if fn.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isGetter is whether fn reads the binding.
+func isGetter(fn *types.Func) bool {
+	return isFunc(fn, glcPath, "GetContext", "GetContextErr")
+}
+
+// isBinder is whether fn calls a function it's passed with a context bound.
+func isBinder(fn *types.Func) bool {
+	return isFunc(fn, glcPath, "WithContext", "WithContextErr", "WithSignals") ||
+		isFunc(fn, glclocalPath, "With")
+}
+
+// isSpawner is whether fn is a method that likely runs the function it's passed
+// on another goroutine, such as errgroup.Group.Go.
+func isSpawner(fn *types.Func) bool {
+	if fn == nil {
+		return false
+	}
+	sig, ok := fn.Type().(*types.Signature)
+	return ok && sig.Recv() != nil && (fn.Name() == "Go" || fn.Name() == "Submit")
+}
+
+type checker struct {
+	pass *analysis.Pass
+	// local holds the functions of this package known to get the context.
+	local map[*types.Func]bool
+}
+
+func (c *checker) callee(call *ast.CallExpr) *types.Func {
+	fn := typeutil.StaticCallee(c.pass.TypesInfo, call)
+	if fn != nil {
+		fn = fn.Origin()
+	}
+	return fn
+}
+
+// getsContext is whether fn gets the context, as far as is known so far.
+func (c *checker) getsContext(fn *types.Func) bool {
+	if fn == nil {
+		return false
+	}
+	if isGetter(fn) || c.local[fn] {
+		return true
+	}
+	return fn.Pkg() != c.pass.Pkg && c.pass.ImportObjectFact(fn, new(getsContext))
+}
+
+// funcValue returns the function `e` names, if it names one.
+func (c *checker) funcValue(e ast.Expr) *types.Func {
+	var id *ast.Ident
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	default:
+		return nil
+	}
+	if fn, ok := c.pass.TypesInfo.Uses[id].(*types.Func); ok {
+		return fn.Origin()
+	}
+	return nil
+}
+
+// bodyGetsContext is whether `body` calls a function that gets the context,
+// other than on a goroutine of its own or within a binding of its own.
+func (c *checker) bodyGetsContext(body ast.Node) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			// The arguments are evaluated on this goroutine, and the
+			// call is made on the new one.
+			for _, arg := range n.Call.Args {
+				if c.bodyGetsContext(arg) {
+					found = true
+				}
+			}
+			return false
+		case *ast.CallExpr:
+			fn := c.callee(n)
+			if isBinder(fn) {
+				// The function passed runs with a binding, so
+				// only the other arguments count.
+				for _, arg := range n.Args {
+					if _, ok := arg.(*ast.FuncLit); !ok {
+						ast.Inspect(arg, func(n ast.Node) bool {
+							if call, ok := n.(*ast.CallExpr); ok && c.getsContext(c.callee(call)) {
+								found = true
+							}
+							return !found
+						})
+					}
+				}
+				return false
+			}
+			if c.getsContext(fn) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// spawnedGetsContext is whether the function `e`, run on a goroutine of its
+// own, gets the context.
+func (c *checker) spawnedGetsContext(e ast.Expr) bool {
+	if lit, ok := ast.Unparen(e).(*ast.FuncLit); ok {
+		return c.bodyGetsContext(lit.Body)
+	}
+	return c.getsContext(c.funcValue(e))
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	c := &checker{pass: pass, local: make(map[*types.Func]bool)}
+
+	// Find the functions that get the context, going round until no more
+	// turn up, since they can call each other in any order.
+	decls := make(map[*types.Func]*ast.FuncDecl)
+	for _, f := range pass.Files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Body != nil {
+				if fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func); ok {
+					decls[fn] = fd
+				}
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for fn, fd := range decls {
+			if !c.local[fn] && c.bodyGetsContext(fd.Body) {
+				c.local[fn] = true
+				changed = true
+			}
+		}
+	}
+	for fn := range c.local {
+		pass.ExportObjectFact(fn, new(getsContext))
+	}
+
+	for _, f := range pass.Files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || !isBinder(c.callee(call)) {
+				return true
+			}
+			for _, arg := range call.Args {
+				if lit, ok := arg.(*ast.FuncLit); ok {
+					c.checkScope(lit.Body)
+				}
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+// checkScope reports the goroutines started in `body`, which runs with a
+// binding, that get the context.
+func (c *checker) checkScope(body ast.Node) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			if c.spawnedGetsContext(n.Call.Fun) {
+				c.pass.Reportf(n.Pos(), "goroutine started in a glc.WithContext scope calls glc.GetContext, but doesn't see the scope's binding; start it with glclocal.Go, or bind the context again inside it")
+			}
+			return false
+		case *ast.CallExpr:
+			fn := c.callee(n)
+			if isBinder(fn) {
+				// Checked on its own.
+				return false
+			}
+			if isSpawner(fn) {
+				for _, arg := range n.Args {
+					if c.spawnedGetsContext(arg) {
+						c.pass.Reportf(n.Pos(), "function passed to %s in a glc.WithContext scope calls glc.GetContext, but runs on another goroutine and doesn't see the scope's binding; bind the context again inside it", fn.Name())
+					}
+				}
+			}
+		}
+		return true
+	})
+}
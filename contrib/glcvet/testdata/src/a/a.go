@@ -0,0 +1,69 @@
+package a
+
+import (
+	"context"
+	"errgroup"
+
+	"b"
+
+	"github.com/knusbaum/glc"
+	"github.com/knusbaum/glc/glclocal"
+)
+
+func direct() { // want direct:"getsContext"
+	_ = glc.GetContext()
+}
+
+// mutual and recursive get the context through each other.
+func mutual(n int) { // want mutual:"getsContext"
+	if n > 0 {
+		recursive(n - 1)
+	}
+}
+
+func recursive(n int) { // want recursive:"getsContext"
+	if n == 0 {
+		_, _ = glc.GetContextErr()
+		return
+	}
+	mutual(n)
+}
+
+func spawn(arg context.Context) {}
+
+func scopes(ctx context.Context) {
+	glc.WithContext(ctx, func() {
+		go direct()              // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+		go b.Work()              // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+		go mutual(3)             // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+		go func() { direct() }() // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+		go b.Pure()
+		go b.Bound(ctx)
+		go spawn(glc.GetContext())
+		glclocal.Go(direct)
+
+		bound := glc.GetContext()
+		go func() {
+			glc.WithContext(bound, direct)
+			glc.WithContext(bound, func() { direct() })
+		}()
+
+		var g errgroup.Group
+		g.Go(func() error { direct(); return nil }) // want `function passed to Go in a glc.WithContext scope calls glc.GetContext`
+		g.Go(func() error { return nil })
+
+		glc.WithContext(ctx, func() {
+			go direct() // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+		})
+	})
+
+	_ = glc.WithContextErr(ctx, func() {
+		go direct() // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+	})
+	glclocal.With(ctx, func() {
+		go direct() // want `goroutine started in a glc.WithContext scope calls glc.GetContext`
+	})
+
+	// Outside any scope, nothing is bound to be missed.
+	go direct()
+}
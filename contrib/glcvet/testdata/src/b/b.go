@@ -0,0 +1,26 @@
+package b
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+)
+
+// Work gets the context through another function.
+func Work() { // want Work:"getsContext"
+	helper()
+}
+
+func helper() { // want helper:"getsContext"
+	_ = glc.GetContext()
+}
+
+// Bound binds a context of its own before getting it.
+func Bound(ctx context.Context) {
+	glc.WithContext(ctx, func() {
+		_ = glc.GetContext()
+	})
+}
+
+// Pure doesn't get the context.
+func Pure() {}
@@ -0,0 +1,8 @@
+package glc
+
+import "context"
+
+func WithContext(ctx context.Context, f func())          {}
+func WithContextErr(ctx context.Context, f func()) error { return nil }
+func GetContext() context.Context                        { return nil }
+func GetContextErr() (context.Context, error)            { return nil, nil }
@@ -0,0 +1,6 @@
+package glclocal
+
+import "context"
+
+func With(ctx context.Context, f func()) {}
+func Go(f func())                        {}
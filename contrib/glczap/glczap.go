@@ -0,0 +1,54 @@
+// Package glczap adds fields from the context bound by glc.WithContext to zap's
+// log entries, so that entries logged by code with no context to hand still say
+// which request they belong to.
+//
+// glczap is a module of its own, so that glc doesn't depend on zap.
+package glczap
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Core returns a core which writes entries with `core`, after adding the fields
+// that `fields` extracts from the context bound by `glc.WithContext`. Entries
+// logged with nothing bound are written as they are.
+//
+// The context is the one bound where the entry is logged, not where the logger
+// was made, so one logger serves every request.
+//
+//	logger := zap.New(glczap.Core(core, func(ctx context.Context) []zap.Field {
+//		return []zap.Field{zap.String("request", requestID(ctx))}
+//	}))
+func Core(core zapcore.Core, fields func(ctx context.Context) []zap.Field) zapcore.Core {
+	return contextCore{Core: core, fields: fields}
+}
+
+type contextCore struct {
+	zapcore.Core
+	fields func(ctx context.Context) []zap.Field
+}
+
+func (c contextCore) With(fields []zapcore.Field) zapcore.Core {
+	return contextCore{Core: c.Core.With(fields), fields: c.fields}
+}
+
+func (c contextCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	// Check the wrapped core's level, but have the entry written by this
+	// one, so that Write adds the fields.
+	if c.Enabled(e.Level) {
+		return ce.AddCore(e, c)
+	}
+	return ce
+}
+
+func (c contextCore) Write(e zapcore.Entry, fields []zapcore.Field) error {
+	if ctx := glc.GetContext(); ctx != nil {
+		// Don't append into the caller's slice.
+		fields = append(fields[:len(fields):len(fields)], c.fields(ctx)...)
+	}
+	return c.Core.Write(e, fields)
+}
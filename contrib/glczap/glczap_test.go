@@ -0,0 +1,58 @@
+package glczap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type key struct{}
+
+func requestFields(ctx context.Context) []zap.Field {
+	if v, ok := ctx.Value(key{}).(string); ok {
+		return []zap.Field{zap.String("request", v)}
+	}
+	return nil
+}
+
+func TestCore(t *testing.T) {
+	obs, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(Core(obs, requestFields)).With(zap.Int("with", 1))
+
+	logger.Info("unbound")
+	glc.WithContext(context.WithValue(context.Background(), key{}, "outer"), func() {
+		logger.Info("outer")
+		glc.WithContext(context.WithValue(glc.GetContext(), key{}, "inner"), func() {
+			logger.Info("inner", zap.Bool("own", true))
+		})
+		logger.Debug("disabled")
+	})
+
+	entries := logs.AllUntimed()
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	for i, want := range []string{"", "outer", "inner"} {
+		m := entries[i].ContextMap()
+		if m["with"] != int64(1) {
+			t.Errorf("%s: lost the logger's own fields: %v", entries[i].Message, m)
+		}
+		got, ok := m["request"]
+		if want == "" {
+			if ok {
+				t.Errorf("%s: got request %v, want none", entries[i].Message, got)
+			}
+			continue
+		}
+		if got != want {
+			t.Errorf("%s: got request %v, want %s", entries[i].Message, got, want)
+		}
+	}
+	if m := entries[2].ContextMap(); m["own"] != true {
+		t.Errorf("inner: lost the entry's own fields: %v", m)
+	}
+}
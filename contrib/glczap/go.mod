@@ -0,0 +1,12 @@
+module github.com/knusbaum/glc/contrib/glczap
+
+go 1.19
+
+require (
+	github.com/knusbaum/glc v0.0.0-00010101000000-000000000000
+	go.uber.org/zap v1.28.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+replace github.com/knusbaum/glc => ../..
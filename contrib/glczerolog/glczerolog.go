@@ -0,0 +1,52 @@
+// Package glczerolog adds fields from the context bound by glc.WithContext to
+// zerolog's events, so that events logged by code with no context to hand still
+// say which request they belong to.
+//
+// glczerolog is a module of its own, so that glc doesn't depend on zerolog.
+package glczerolog
+
+import (
+	"context"
+
+	"github.com/knusbaum/glc"
+	"github.com/rs/zerolog"
+)
+
+// Hook is a zerolog.Hook which calls Fields to add fields from a context to
+// every event.
+//
+// The context is the event's own, if it was given one with Ctx, and otherwise
+// the context bound by `glc.WithContext` where the event is logged. An event is
+// taken to have no context of its own if it has `context.Background()` or
+// `context.TODO()`. Events with no context at all are left alone.
+//
+//	logger := zerolog.New(os.Stderr).Hook(glczerolog.Hook{
+//		Fields: func(ctx context.Context, e *zerolog.Event) {
+//			e.Str("request", requestID(ctx))
+//		},
+//	})
+type Hook struct {
+	Fields func(ctx context.Context, e *zerolog.Event)
+}
+
+// Run adds the fields to `e`.
+func (h Hook) Run(e *zerolog.Event, level zerolog.Level, message string) {
+	ctx := e.GetCtx()
+	if ctx == context.Background() || ctx == context.TODO() {
+		ctx = glc.GetContext()
+	}
+	if ctx != nil {
+		h.Fields(ctx, e)
+	}
+}
+
+// With returns a zerolog.Context for a logger like `l`, but carrying the context
+// bound by `glc.WithContext`, so that its events have that context when they're
+// logged later, wherever that is. If nothing is bound, it's just `l.With()`.
+func With(l zerolog.Logger) zerolog.Context {
+	c := l.With()
+	if ctx := glc.GetContext(); ctx != nil {
+		c = c.Ctx(ctx)
+	}
+	return c
+}
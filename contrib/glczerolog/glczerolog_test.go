@@ -0,0 +1,89 @@
+package glczerolog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"github.com/rs/zerolog"
+)
+
+type key struct{}
+
+func requestFields(ctx context.Context, e *zerolog.Event) {
+	if v, ok := ctx.Value(key{}).(string); ok {
+		e.Str("request", v)
+	}
+}
+
+// requests returns the request field of each event in buf, or "" for events
+// without one.
+func requests(t *testing.T, buf *bytes.Buffer) []string {
+	t.Helper()
+	var got []string
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var ev struct{ Request string }
+		if err := dec.Decode(&ev); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, ev.Request)
+	}
+	return got
+}
+
+func TestHook(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf).Hook(Hook{Fields: requestFields})
+
+	logger.Info().Msg("unbound")
+	glc.WithContext(context.WithValue(context.Background(), key{}, "outer"), func() {
+		logger.Info().Msg("outer")
+		glc.WithContext(context.WithValue(glc.GetContext(), key{}, "inner"), func() {
+			logger.Info().Msg("inner")
+		})
+		logger.Info().Ctx(context.WithValue(context.Background(), key{}, "event")).Msg("own context")
+	})
+
+	want := []string{"", "outer", "inner", "event"}
+	got := requests(t, &buf)
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d: got request %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWith(t *testing.T) {
+	var buf bytes.Buffer
+	base := zerolog.New(&buf).Hook(Hook{Fields: requestFields})
+
+	var logger zerolog.Logger
+	glc.WithContext(context.WithValue(context.Background(), key{}, "made"), func() {
+		logger = With(base).Logger()
+	})
+	// Logged outside any scope, but the logger kept the context it was made
+	// under.
+	logger.Info().Msg("later")
+	glc.WithContext(context.WithValue(context.Background(), key{}, "other"), func() {
+		logger.Info().Msg("elsewhere")
+	})
+	unbound := With(base).Logger()
+	unbound.Info().Msg("unbound")
+
+	want := []string{"made", "made", ""}
+	got := requests(t, &buf)
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d: got request %q, want %q", i, got[i], want[i])
+		}
+	}
+}
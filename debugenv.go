@@ -0,0 +1,137 @@
+package glc
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The GLCDEBUG environment variable turns on glc's runtime checks without
+// rebuilding the program. Like GODEBUG, it's a comma-separated list of
+// name=value settings, read once when the program starts:
+//
+//	decodecheck=1   check every decode against a slow reference decoder, as
+//	                glc_debug builds do. Disagreements are counted as
+//	                DecoderMismatch failures.
+//	leakwatch=5s    run a WatchLeaks watchdog that logs bindings live for
+//	                longer than 5s.
+//	log=stderr      where to log decoder disagreements and leaks: stderr,
+//	                stdout, or off. glc_debug builds log with the log
+//	                package's standard logger by default. Other builds log
+//	                to stderr if decodecheck=1 or leakwatch is set, and
+//	                don't log otherwise.
+//
+// For example:
+//
+//	GLCDEBUG=decodecheck=1,leakwatch=5s,log=stderr ./server
+//
+// Settings glc doesn't know about are reported on stderr and otherwise ignored.
+
+// debugLogger is where GLCDEBUG's checks log to, or nil if they don't.
+var debugLogger atomic.Pointer[log.Logger]
+
+func debugf(format string, args ...any) {
+	if l := debugLogger.Load(); l != nil {
+		l.Printf(format, args...)
+	}
+}
+
+var debugEnv struct {
+	sync.Mutex
+	stopLeakWatch func()
+}
+
+func init() {
+	if err := setDebugEnv(os.Getenv("GLCDEBUG")); err != nil {
+		fmt.Fprintf(os.Stderr, "glc: GLCDEBUG: %v\n", err)
+	}
+}
+
+// setDebugEnv applies the GLCDEBUG settings in s. Settings missing from s go
+// back to their defaults. Bad settings are skipped and returned as an error
+// once the rest have been applied.
+func setDebugEnv(s string) error {
+	check := debugBuild
+	var logger *log.Logger
+	if debugBuild {
+		logger = log.Default()
+	}
+	var leakwatch time.Duration
+	// logSet is whether a good log setting was given.
+	var logSet bool
+
+	var errs []string
+	for _, setting := range strings.Split(s, ",") {
+		if setting == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(setting, "=")
+		switch name {
+		case "decodecheck":
+			switch value {
+			case "0":
+				check = false
+			case "1":
+				check = true
+			default:
+				errs = append(errs, fmt.Sprintf("decodecheck=%q, want 0 or 1", value))
+			}
+		case "leakwatch":
+			d, err := time.ParseDuration(value)
+			if err != nil || d < 0 {
+				errs = append(errs, fmt.Sprintf("leakwatch=%q, want a duration", value))
+				continue
+			}
+			leakwatch = d
+		case "log":
+			switch value {
+			case "stderr":
+				logger, logSet = log.New(os.Stderr, "", log.LstdFlags), true
+			case "stdout":
+				logger, logSet = log.New(os.Stdout, "", log.LstdFlags), true
+			case "off":
+				logger, logSet = nil, true
+			default:
+				errs = append(errs, fmt.Sprintf("log=%q, want stderr, stdout or off", value))
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("unknown setting %q", setting))
+		}
+	}
+
+	if !logSet && logger == nil && (check || leakwatch > 0) {
+		// A check that's been asked for, but logs nowhere, costs
+		// without telling anyone anything.
+		logger = log.New(os.Stderr, "", log.LstdFlags)
+	}
+	debugLogger.Store(logger)
+	decodeCheck.Store(check)
+
+	debugEnv.Lock()
+	defer debugEnv.Unlock()
+	if debugEnv.stopLeakWatch != nil {
+		debugEnv.stopLeakWatch()
+		debugEnv.stopLeakWatch = nil
+	}
+	if leakwatch > 0 {
+		debugEnv.stopLeakWatch = WatchLeaks(leakwatch, logLeak)
+	}
+
+	if errs != nil {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// logLeak is the hook for GLCDEBUG's leak watchdog.
+func logLeak(l Leak) {
+	site := "before the watchdog started"
+	if l.Site.Function != "" {
+		site = fmt.Sprintf("by %s at %s:%d", l.Site.Function, l.Site.File, l.Site.Line)
+	}
+	debugf("glc: binding %d has been live for %v, made %s\n\tcontext: %v\n%s", l.ID, l.Age, site, l.Context, l.Stack)
+}
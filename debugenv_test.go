@@ -0,0 +1,109 @@
+package glc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer is a bytes.Buffer the leak watchdog can write to while a test
+// reads it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestDebugEnv(t *testing.T) {
+	defer setDebugEnv("")
+
+	if err := setDebugEnv("decodecheck=1,log=off"); err != nil {
+		t.Fatal(err)
+	}
+	if !decodeCheck.Load() || debugLogger.Load() != nil {
+		t.Errorf("decodecheck=1,log=off: got decodecheck %t, logger %v", decodeCheck.Load(), debugLogger.Load())
+	}
+	before := DecodeFailures(DecoderMismatch)
+	WithContext(context.Background(), func() {
+		stackit(100, func() { GetContext() })
+	})
+	if n := DecodeFailures(DecoderMismatch) - before; n != 0 {
+		t.Errorf("%d decoder mismatches", n)
+	}
+
+	if err := setDebugEnv("decodecheck=0"); err != nil {
+		t.Fatal(err)
+	}
+	if decodeCheck.Load() {
+		t.Error("decodecheck=0 left decode checking on")
+	}
+
+	err := setDebugEnv("decodecheck=2,bogus=1,log=stderr")
+	if err == nil || !strings.Contains(err.Error(), "decodecheck") || !strings.Contains(err.Error(), "bogus") {
+		t.Errorf("got error %v, want one about decodecheck and bogus", err)
+	}
+	if debugLogger.Load() == nil {
+		t.Error("log=stderr wasn't applied alongside bad settings")
+	}
+}
+
+func TestDebugEnvDefaultLog(t *testing.T) {
+	defer setDebugEnv("")
+	for _, tt := range []struct {
+		env string
+		log bool
+	}{
+		{"leakwatch=1h", true},
+		{"decodecheck=1", true},
+		{"leakwatch=1h,log=off", false},
+		{"decodecheck=1,log=off", false},
+		{"", debugBuild},
+	} {
+		if err := setDebugEnv(tt.env); err != nil {
+			t.Fatal(err)
+		}
+		if got := debugLogger.Load() != nil; got != tt.log {
+			t.Errorf("GLCDEBUG=%s: logging %t, want %t", tt.env, got, tt.log)
+		}
+	}
+}
+
+func TestDebugEnvLeakWatch(t *testing.T) {
+	defer setDebugEnv("")
+	if err := setDebugEnv("leakwatch=10ms,log=off"); err != nil {
+		t.Fatal(err)
+	}
+	var buf syncBuffer
+	debugLogger.Store(log.New(&buf, "", 0))
+
+	WithContext(context.Background(), func() {
+		id, _ := BindingID()
+		want := fmt.Sprintf("glc: binding %d has been live for ", id)
+		deadline := time.Now().Add(5 * time.Second)
+		for !strings.Contains(buf.String(), want) {
+			if time.Now().After(deadline) {
+				t.Fatalf("no %q in log:\n%s", want, buf.String())
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		if !strings.Contains(buf.String(), "TestDebugEnvLeakWatch") {
+			t.Errorf("no creation site in log:\n%s", buf.String())
+		}
+	})
+}
@@ -0,0 +1,16 @@
+//go:build !glc_debug
+
+package glc
+
+// debugBuild is whether this is a glc_debug build.
+const debugBuild = false
+
+func decode(want encoding, live func(id uint64) bool) (value uint64, ok bool, miss DecodeFailureKind) {
+	value, ok, miss = chunkedlast(want, live)
+	if decodeCheck.Load() {
+		checkDecode(want, live, value, ok)
+	}
+	return value, ok, miss
+}
+
+func debugMetrics(m map[string]any) {}
@@ -0,0 +1,76 @@
+//go:build glc_debug
+
+package glc
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Building with the glc_debug tag checks every decode against referencelast, a
+// decoder simple enough to trust, and keeps track of how long decodes take:
+//
+//	go test -tags glc_debug ./...
+//
+// Disagreements are logged along with the stack they happened on. This is far
+// too slow for normal use, but cheap enough to run a test suite or a staging
+// deployment under. GLCDEBUG=decodecheck=1 turns on the checking, but not the
+// timing, in any build; in glc_debug builds, GLCDEBUG=decodecheck=0 turns it
+// off.
+
+// debugBuild is whether this is a glc_debug build.
+const debugBuild = true
+
+// DebugDecodeStats describes the decodes done so far. It only exists in builds
+// with the glc_debug tag.
+type DebugDecodeStats struct {
+	// Decodes is the number of decodes done by GetContext and DecodeValue.
+	Decodes int64
+	// Anomalies is the number of those whose result didn't match the
+	// reference decoder's.
+	Anomalies int64
+	// Total and Max are the total and longest time spent in the decoder,
+	// not counting the reference decoder.
+	Total, Max time.Duration
+}
+
+var debugStats struct {
+	decodes, anomalies, total, max atomic.Int64
+}
+
+// DebugStats returns the decode statistics kept in glc_debug builds.
+func DebugStats() DebugDecodeStats {
+	return DebugDecodeStats{
+		Decodes:   debugStats.decodes.Load(),
+		Anomalies: debugStats.anomalies.Load(),
+		Total:     time.Duration(debugStats.total.Load()),
+		Max:       time.Duration(debugStats.max.Load()),
+	}
+}
+
+func decode(want encoding, live func(id uint64) bool) (value uint64, ok bool, miss DecodeFailureKind) {
+	start := time.Now()
+	v, ok, miss := chunkedlast(want, live)
+	d := int64(time.Since(start))
+
+	debugStats.decodes.Add(1)
+	debugStats.total.Add(d)
+	for max := debugStats.max.Load(); d > max; max = debugStats.max.Load() {
+		if debugStats.max.CompareAndSwap(max, d) {
+			break
+		}
+	}
+
+	if decodeCheck.Load() && !checkDecode(want, live, v, ok) {
+		debugStats.anomalies.Add(1)
+	}
+	return v, ok, miss
+}
+
+func debugMetrics(m map[string]any) {
+	s := DebugStats()
+	m["decodes"] = s.Decodes
+	m["decode_anomalies"] = s.Anomalies
+	m["decode_ns_total"] = int64(s.Total)
+	m["decode_ns_max"] = int64(s.Max)
+}
@@ -0,0 +1,33 @@
+//go:build glc_debug
+
+package glc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDebugDecode(t *testing.T) {
+	before := DebugStats()
+	GetContext()
+	WithContext(context.Background(), func() {
+		EncodeInto(7, func() {
+			for depth := 0; depth < 500; depth += 50 {
+				stackit(depth, func() {
+					GetContext()
+					DecodeValue()
+				})
+			}
+		})
+	})
+	after := DebugStats()
+	if n := after.Decodes - before.Decodes; n != 21 {
+		t.Errorf("counted %d decodes, want 21", n)
+	}
+	if after.Anomalies != before.Anomalies {
+		t.Errorf("%d anomalies", after.Anomalies-before.Anomalies)
+	}
+	if after.Max <= 0 || after.Total < after.Max {
+		t.Errorf("timings don't add up: %+v", after)
+	}
+}
@@ -0,0 +1,66 @@
+package glc
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// decodeCheck is whether every decode is checked against referencelast. It's
+// set by glc_debug builds and GLCDEBUG=decodecheck=1.
+var decodeCheck atomic.Bool
+
+// checkDecode compares a decode's result with referencelast's, and reports
+// whether they agree. Disagreements are counted as DecoderMismatch failures and
+// logged.
+func checkDecode(want encoding, live func(id uint64) bool, v uint64, ok bool) bool {
+	rv, rok := referencelast(want, live)
+	if rv != v && ok || rok != ok {
+		decodeFailed(DecoderMismatch, v)
+		debugf("glc: decoder found (%d, %t), reference decoder found (%d, %t)\n%s", v, ok, rv, rok, debug.Stack())
+		return false
+	}
+	return true
+}
+
+// referencelast is chunkedlast done the slow way: it copies the whole stack,
+// and asks the runtime about every frame.
+func referencelast(want encoding, live func(id uint64) bool) (uint64, bool) {
+	pcs := make([]uintptr, 1024)
+	for {
+		if n := runtime.Callers(0, pcs); n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+	frames := runtime.CallersFrames(pcs)
+	for frame, more := frames.Next(); more; frame, more = frames.Next() {
+		if frame.Entry != encendpc {
+			continue
+		}
+		var value uint64
+		for frame, more = frames.Next(); more && frame.Entry != encstartpc; frame, more = frames.Next() {
+			v, ok := encmap[frame.Entry]
+			if !ok {
+				return 0, false
+			}
+			value = value<<8 | uint64(v)
+		}
+		if !more {
+			return 0, false
+		}
+		frame, more = frames.Next()
+		got := encodingID
+		if frame.Entry == encvaluepc {
+			got = encodingValue
+		}
+		if got == want && (live == nil || live(value)) {
+			return value, true
+		}
+		if !more {
+			break
+		}
+	}
+	return 0, false
+}
@@ -2,7 +2,11 @@ package glc
 
 import (
 	"fmt"
+	"reflect"
 	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 //go:generate bash -c "./generate.sh >encoder.go && gofmt -w encoder.go"
@@ -11,7 +15,327 @@ func lastID() (uint64, bool) {
 	//return slowlastID()
 	//return fastlastID()
 	//return fasterlastID()
-	return fastestlastID()
+	//return fastestlastID()
+	id, ok, _ := decode(encodingID, nil)
+	return id, ok
+}
+
+func lastValue() (uint64, bool) {
+	v, ok, _ := decode(encodingValue, nil)
+	return v, ok
+}
+
+// encoding distinguishes the encodings made by WithContext from those made by
+// EncodeInto. Both share the same encoding functions, so a decode has to look
+// past encstart to encstart's caller to tell which it has found.
+type encoding int
+
+const (
+	encodingID encoding = iota
+	encodingValue
+)
+
+// encendret is the address encend returns to after calling cont. encend makes
+// only the one call, so this is the only address within encend that can show up
+// in anybody else's stack.
+//
+// fastestlastID guesses at this with the size of encend instead, which goes
+// wrong as soon as the compiler grows encend, for instance under -race.
+var encendret = func() uintptr {
+	var pc [1]uintptr
+	// Skip runtime.Callers and this closure.
+	encend(func() { runtime.Callers(2, pc[:]) })
+	if e := runtime.FuncForPC(pc[0]).Entry(); e != uintptr(reflect.ValueOf(encend).UnsafePointer()) {
+		panic(fmt.Sprintf("glc: expected return address within encend, but got one within %s", runtime.FuncForPC(pc[0]).Name()))
+	}
+	return pc[0]
+}()
+
+// decodeChunk is the number of frames chunkedlast looks at from a buffer on its
+// own stack. Most calls to GetContext happen close to the WithContext that
+// bound the context, so the chunk usually holds the whole encoding.
+const decodeChunk = 64
+
+// depthHint memoizes the deepest frame a decode has found an encoding at.
+// Once it's past decodeChunk, decodes go straight to a buffer that deep, and
+// unwind the stack once, instead of unwinding the chunk first and then again
+// to get past it.
+var depthHint int64
+
+var pcPool = sync.Pool{
+	New: func() any {
+		pcs := make([]uintptr, 0)
+		return &pcs
+	},
+}
+
+// chunkedlast decodes the most recent encoding of kind `want` on the stack
+// without copying more of the stack than it needs. While decodes have only
+// needed the first decodeChunk frames, it looks at those from a buffer on its
+// own stack. Otherwise, or if the encoding isn't in there, it asks the runtime
+// for as many frames as depthHint says, and for twice as many each time that
+// isn't enough.
+//
+// runtime.Callers can't resume an unwind, and has to walk the frames it skips,
+// so every retry walks the stack again from the top. The cost of unwinding is
+// proportional to the distance between GetContext and the WithContext that
+// bound the context.
+//
+// If live is not nil, encodings it returns false for are passed over. If
+// chunkedlast finds nothing, miss says why, and value is the first ID passed
+// over, if there was one.
+func chunkedlast(want encoding, live func(id uint64) bool) (value uint64, ok bool, miss DecodeFailureKind) {
+	d := idDecoder{want: want, live: live}
+	if profiling.Load() {
+		start := time.Now()
+		defer func() { recordDecode(time.Since(start), d.frames) }()
+	}
+	if purego {
+		return d.scanNamed()
+	}
+	hint := int(atomic.LoadInt64(&depthHint))
+	var scanned int
+	if hint <= decodeChunk {
+		var pcs [decodeChunk]uintptr
+		count := runtime.Callers(0, pcs[:])
+		if id, ok, done := d.scan(pcs[:count]); done {
+			return id, ok, 0
+		}
+		if count < len(pcs) {
+			return d.missed()
+		}
+		scanned = count
+	}
+
+	n := hint
+	if n < 2*decodeChunk {
+		n = 2 * decodeChunk
+	}
+	bufp := pcPool.Get().(*[]uintptr)
+	defer pcPool.Put(bufp)
+	for {
+		if cap(*bufp) < n {
+			*bufp = make([]uintptr, n)
+		}
+		buf := (*bufp)[:n]
+		// The frames up to scanned are the same as last time, apart from
+		// this one, so only the ones after them need scanning.
+		count := runtime.Callers(0, buf)
+		if id, ok, done := d.scan(buf[scanned:count]); done {
+			if depth := int64(d.frames); ok && depth > atomic.LoadInt64(&depthHint) {
+				atomic.StoreInt64(&depthHint, depth)
+			}
+			return id, ok, 0
+		}
+		if count < len(buf) {
+			return d.missed()
+		}
+		scanned = count
+		n *= 2
+	}
+}
+
+// idDecoder holds the state of a decode that may be spread over several chunks
+// of the stack.
+type idDecoder struct {
+	want     encoding
+	live     func(id uint64) bool
+	value    uint64
+	n        int // the number of bytes in value
+	frames   int // the number of frames scanned so far
+	decoding bool
+	started  bool
+	// dead is the first ID live returned false for, if sawDead.
+	dead    uint64
+	sawDead bool
+}
+
+// found decides whether the encoding just read, of the kind d wants, is the
+// one d is looking for.
+func (d *idDecoder) found() bool {
+	if d.live == nil || d.live(d.value) {
+		return true
+	}
+	// The binding's scope has exited, but its frames are still on the
+	// stack, because a panic is unwinding through them and has stopped to
+	// run a deferred call. That call is in the enclosing scope, so carry on
+	// looking outward.
+	if !d.sawDead {
+		d.dead, d.sawDead = d.value, true
+	}
+	return false
+}
+
+// missed is what chunkedlast returns when it finds nothing.
+func (d *idDecoder) missed() (uint64, bool, DecodeFailureKind) {
+	switch {
+	case d.decoding:
+		return 0, false, TruncatedStack
+	case d.sawDead:
+		return d.dead, false, UnknownID
+	}
+	return 0, false, NoBinding
+}
+
+// encodingBytes is the number of bytes in every encoding, one for each frame
+// between encend and encstart.
+const encodingBytes = encodingFrames - 2
+
+// decoderFingerprint is the encoderFingerprint of the encoding this decoder
+// reads. If encoder.go is older than generate.sh, it won't match, or there
+// will be no encoderFingerprint at all and the package won't build.
+const decoderFingerprint = "generator=1 radix=256 bytes=8"
+
+// checkEncoder makes sure encoder.go is the encoder this decoder was written
+// for. A stale encoder.go doesn't fail on its own; it just decodes the wrong
+// IDs. The generated init calls checkEncoder before anything else, and
+// checkEncmap once it has filled in encmap.
+func checkEncoder() {
+	if encoderFingerprint != decoderFingerprint {
+		panic(fmt.Sprintf("glc: encoder.go implements encoding %q, but the decoder reads %q; regenerate it with go generate", encoderFingerprint, decoderFingerprint))
+	}
+}
+
+func checkEncmap() {
+	if len(encmap) != 256 {
+		panic(fmt.Sprintf("glc: encoder.go has %d encoding functions, want 256; regenerate it with go generate", len(encmap)))
+	}
+}
+
+// scan continues decoding with the next chunk of the stack. done is true once
+// scan has either found an encoding of the kind it wants or determined there
+// isn't one.
+func (d *idDecoder) scan(stack []uintptr) (id uint64, ok bool, done bool) {
+	if exttable == nil {
+		return d.scanRuntime(stack)
+	}
+	return d.scanExtents(stack)
+}
+
+// scanExtents is scan for when there is an exttable.
+func (d *idDecoder) scanExtents(stack []uintptr) (id uint64, ok bool, done bool) {
+	for i, pc := range stack {
+		if d.step(extentAt(pc)) {
+			d.frames += i + 1
+			return d.value, true, true
+		}
+	}
+	d.frames += len(stack)
+	return 0, false, false
+}
+
+// step continues decoding with the next frame, whose function has the extent
+// ext. It returns true once d has found an encoding of the kind it wants.
+func (d *idDecoder) step(ext uint16) bool {
+	if d.started {
+		// The frame belongs to the caller of encstart.
+		kind := encodingID
+		if ext == extValue {
+			kind = encodingValue
+		}
+		if kind == d.want && d.found() {
+			return true
+		}
+		d.decoding, d.started = false, false
+		return false
+	}
+	if !d.decoding {
+		if ext == extEnd {
+			d.decoding = true
+			d.value, d.n = 0, 0
+		}
+		return false
+	}
+	switch {
+	case ext == extEnd:
+		// Real stacks never have an encend in the middle of an
+		// encoding, but if this one does, the encoding we were reading
+		// is broken. Start again from here.
+		d.value, d.n = 0, 0
+	case ext == extStart:
+		// Likewise, an encoding with the wrong number of bytes can't be
+		// trusted.
+		d.started = d.n == encodingBytes
+		d.decoding = d.started
+	case ext > extNone && ext < extStart:
+		d.value <<= 8
+		d.value |= uint64(ext - 1)
+		d.n++
+	}
+	// Otherwise, non-encoding interim program counter
+	return false
+}
+
+// scanNamed decodes the whole stack in one go, recognizing the encoding
+// functions by the names runtime.CallersFrames gives them. It's what purego
+// builds do in place of scan.
+func (d *idDecoder) scanNamed() (uint64, bool, DecodeFailureKind) {
+	pcs := make([]uintptr, 2*decodeChunk)
+	for {
+		if n := runtime.Callers(0, pcs); n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+	frames := runtime.CallersFrames(pcs)
+	for more := len(pcs) > 0; more; {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		d.frames++
+		if d.step(extentOfName(frame.Function)) {
+			return d.value, true, 0
+		}
+	}
+	return d.missed()
+}
+
+// scanRuntime is scan for when there is no exttable. It asks the runtime which
+// function each address is in, by way of the pc cache.
+func (d *idDecoder) scanRuntime(stack []uintptr) (id uint64, ok bool, done bool) {
+	for i, pc := range stack {
+		if d.started {
+			// pc belongs to the caller of encstart.
+			kind := encodingID
+			if entryForPC(pc) == encvaluepc {
+				kind = encodingValue
+			}
+			if kind == d.want && d.found() {
+				d.frames += i + 1
+				return d.value, true, true
+			}
+			d.decoding, d.started = false, false
+			continue
+		}
+		if !d.decoding {
+			if pc == encendret {
+				d.decoding = true
+				d.value, d.n = 0, 0
+			}
+			continue
+		}
+		if pc == encendret {
+			// See scanExtents.
+			d.value, d.n = 0, 0
+			continue
+		}
+		e := entryForPC(pc)
+		if e == encstartpc {
+			d.started = d.n == encodingBytes
+			d.decoding = d.started
+			continue
+		}
+		v, ok := valForPC(e)
+		if !ok {
+			// Non-encoding interim program counter
+			continue
+		}
+		d.value <<= 8
+		d.value |= uint64(v)
+		d.n++
+	}
+	d.frames += len(stack)
+	return 0, false, false
 }
 
 func fastestlastID() (uint64, bool) {
@@ -44,10 +368,10 @@ func fastestlastID() (uint64, bool) {
 					value <<= 8
 					value |= uint64(v)
 				}
-			} else {
-				e := runtime.FuncForPC(pc).Entry()
-				panic(fmt.Sprintf("EXPENSIVE! Expected encendpc(%d) but got %d\n", encendpc, e))
 			}
+			// Otherwise, pc is within whatever follows encend. This doesn't
+			// happen with the current layout, but isn't worth dying over if it
+			// does.
 		}
 	}
 	return 0, false
@@ -94,8 +418,8 @@ func fastlastID() (uint64, bool) {
 				if e == encstartpc {
 					return value, true
 				}
-				// A switch will be more efficient, but not sure how much, or
-				// if it's worth it. We should benchmark this.
+				// BenchmarkValForPC shows the map is faster than the switch,
+				// but slower still than valForPC's table.
 				v, ok := encmap[e]
 				if !ok {
 					// Non-encoding interim program counter
@@ -121,8 +445,8 @@ func slowlastID() (uint64, bool) {
 				if frame.Entry == encstartpc {
 					return value, true
 				}
-				// A switch will be more efficient, but not sure how much, or
-				// if it's worth it. We should benchmark this.
+				// BenchmarkValForPC shows the map is faster than the switch,
+				// but slower still than valForPC's table.
 				v, ok := encmap[frame.Entry]
 				if !ok {
 					return 0, false
@@ -0,0 +1,174 @@
+package glc
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// fuzzStack builds a synthetic stack, innermost frame first, from a fuzzer's
+// program. Each byte of the program says what comes next on the stack:
+//
+//	0  a whole encoding of the ID in the next 8 bytes, under a WithContext
+//	1  a whole encoding of the value in the next 8 bytes, under an EncodeInto
+//	2  encend
+//	3  encstart
+//	4  the encoding function for the next byte
+//	5  encvalue
+//	6  the address in the next 8 bytes, whatever it is
+//	7+ some function that has nothing to do with encodings
+func fuzzStack(prog []byte) []uintptr {
+	bytepc := make(map[byte]uintptr, len(encmap))
+	for pc, v := range encmap {
+		bytepc[v] = pc + 1
+	}
+	other := reflect.ValueOf(stackit).Pointer() + 1
+	next8 := func() uint64 {
+		var b [8]byte
+		prog = prog[copy(b[:], prog):]
+		return binary.BigEndian.Uint64(b[:])
+	}
+	var stack []uintptr
+	for len(prog) > 0 {
+		op := prog[0]
+		prog = prog[1:]
+		switch op {
+		case 0, 1:
+			v := next8()
+			stack = append(stack, encendret)
+			// The most significant byte is innermost.
+			for i := encodingBytes - 1; i >= 0; i-- {
+				stack = append(stack, bytepc[byte(v>>(8*i))])
+			}
+			stack = append(stack, encstartpc+1)
+			if op == 1 {
+				stack = append(stack, encvaluepc+1)
+			} else {
+				stack = append(stack, other)
+			}
+		case 2:
+			stack = append(stack, encendret)
+		case 3:
+			stack = append(stack, encstartpc+1)
+		case 4:
+			if len(prog) > 0 {
+				stack = append(stack, bytepc[prog[0]])
+				prog = prog[1:]
+			}
+		case 5:
+			stack = append(stack, encvaluepc+1)
+		case 6:
+			stack = append(stack, uintptr(next8()))
+		default:
+			stack = append(stack, other)
+		}
+	}
+	return stack
+}
+
+// modelDecode is what the decoder should make of a stack whose frames have the
+// given extents. It accepts only encodings that are exactly encend, 8 bytes and
+// encstart, with nothing but unrelated frames in between.
+func modelDecode(exts []uint16, want encoding) (uint64, bool) {
+	for i := 0; i < len(exts); i++ {
+		if exts[i] != extEnd {
+			continue
+		}
+		var value uint64
+		var n int
+		j := i + 1
+		for ; j < len(exts) && exts[j] != extEnd && exts[j] != extStart; j++ {
+			if exts[j] > extNone && exts[j] < extStart {
+				value = value<<8 | uint64(exts[j]-1)
+				n++
+			}
+		}
+		switch {
+		case j == len(exts):
+			return 0, false
+		case exts[j] == extEnd:
+			// Start again from the encend at j.
+			i = j - 1
+			continue
+		case n != encodingBytes:
+			// Carry on after the encstart at j.
+			i = j
+			continue
+		case j+1 == len(exts):
+			return 0, false
+		}
+		kind := encodingID
+		if exts[j+1] == extValue {
+			kind = encodingValue
+		}
+		if kind == want {
+			return value, true
+		}
+		// Carry on after encstart's caller.
+		i = j + 1
+	}
+	return 0, false
+}
+
+// FuzzDecoder checks that both scanners find exactly the encoding modelDecode
+// does, whatever the stack looks like and however it is split into chunks.
+func FuzzDecoder(f *testing.F) {
+	id := []byte{0, 0, 0, 0, 0, 1, 2, 3}
+	f.Add(append([]byte{7, 0}, id...), uint8(0))
+	f.Add(append(append([]byte{1}, id...), append([]byte{0}, id...)...), uint8(3))
+	// Truncated, and with garbage in the middle.
+	f.Add([]byte{2, 4, 1, 4, 2, 3, 7, 2, 4, 0, 7, 4, 1, 4, 2, 4, 3, 4, 4, 4, 5, 4, 6, 4, 7, 3, 7}, uint8(5))
+	// Duplicated encend.
+	f.Add(append([]byte{2, 2, 2, 0}, id...), uint8(1))
+	f.Add([]byte{6, 1, 2, 3, 4, 5, 6, 7, 8, 6, 0, 0, 0, 0, 0, 0, 0, 0}, uint8(2))
+
+	if purego {
+		f.Skip("purego builds have no extent table")
+	}
+	if exttable == nil {
+		f.Fatal("no extent table")
+	}
+	f.Fuzz(func(t *testing.T, prog []byte, chunk uint8) {
+		stack := fuzzStack(prog)
+		extents := make([]uint16, len(stack))
+		runtimeExtents := make([]uint16, len(stack))
+		for i, pc := range stack {
+			extents[i] = extentByRuntime(pc)
+			runtimeExtents[i] = extents[i]
+			// scanRuntime only knows encend by the one address it
+			// returns to.
+			if extents[i] == extEnd && pc != encendret {
+				runtimeExtents[i] = extNone
+			}
+		}
+		for _, want := range []encoding{encodingID, encodingValue} {
+			for _, sc := range []struct {
+				name string
+				scan func(*idDecoder, []uintptr) (uint64, bool, bool)
+				exts []uint16
+			}{
+				{"scanExtents", (*idDecoder).scanExtents, extents},
+				{"scanRuntime", (*idDecoder).scanRuntime, runtimeExtents},
+			} {
+				wantv, wantok := modelDecode(sc.exts, want)
+				d := idDecoder{want: want}
+				var v uint64
+				var ok bool
+				for rest := stack; len(rest) > 0; {
+					n := int(chunk) + 1
+					if n > len(rest) {
+						n = len(rest)
+					}
+					var done bool
+					if v, ok, done = sc.scan(&d, rest[:n]); done {
+						break
+					}
+					rest = rest[n:]
+				}
+				if v != wantv || ok != wantok {
+					t.Fatalf("%s for %v: got (%d, %t), want (%d, %t)\nextents %v", sc.name, want, v, ok, wantv, wantok, sc.exts)
+				}
+			}
+		}
+	})
+}
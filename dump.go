@@ -0,0 +1,99 @@
+package glc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DumpState writes a description of every live binding to `w`: its ID, its
+// context, and the stack of the goroutine it's bound on. The age of a binding and
+// the place it was made are only known for bindings made while `WatchLeaks` was
+// running.
+//
+// The goroutine is found by decoding the encodings in the tracebacks of all
+// goroutines, which the runtime cuts short for very deep stacks, so it can't
+// always be found.
+//
+// DumpState stops the world to collect the tracebacks, so it is for debugging
+// rather than for calling routinely.
+func DumpState(w io.Writer) error {
+	now := sinceEpoch()
+	var bs []*binding
+	idmap.Range(func(b *binding) bool {
+		bs = append(bs, b)
+		return true
+	})
+	sort.Slice(bs, func(i, j int) bool { return bs[i].id < bs[j].id })
+	stacks := goroutineBindings(allStacks())
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "glc: %d live bindings\n", len(bs))
+	printed := make(map[string]bool)
+	for _, b := range bs {
+		fmt.Fprintf(bw, "\nbinding %d", b.id)
+		if created := b.created.Load(); created != 0 {
+			fmt.Fprintf(bw, ", age %v", time.Duration(now-created))
+		}
+		if b.site[0] != 0 {
+			f, _ := runtime.CallersFrames(b.site[:]).Next()
+			fmt.Fprintf(bw, ", made by %s at %s:%d", f.Function, f.File, f.Line)
+		}
+		fmt.Fprintf(bw, "\n\tcontext: %v\n", b.ctx)
+		switch s, ok := stacks[b.id]; {
+		case !ok:
+			fmt.Fprintf(bw, "\tgoroutine not found\n")
+		case printed[s]:
+			header, _, _ := strings.Cut(s, "\n")
+			fmt.Fprintf(bw, "\t%s, above\n", strings.TrimSuffix(header, ":"))
+		default:
+			printed[s] = true
+			for _, line := range strings.Split(s, "\n") {
+				fmt.Fprintf(bw, "\t%s\n", line)
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// allStacks returns the tracebacks of all goroutines, as formatted by
+// runtime.Stack.
+func allStacks() string {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// goroutineBindings decodes the bindings in each goroutine's traceback, and
+// returns each binding's goroutine's traceback by binding ID.
+func goroutineBindings(stacks string) map[uint64]string {
+	byID := make(map[uint64]string)
+	for _, g := range strings.Split(strings.TrimSpace(stacks), "\n\n") {
+		// Decode the goroutine's bindings as GetContext would, but pass
+		// over every one, so as to find them all.
+		d := idDecoder{want: encodingID, live: func(id uint64) bool {
+			byID[id] = g
+			return false
+		}}
+		for _, line := range strings.Split(g, "\n")[1:] {
+			if strings.HasPrefix(line, "\t") {
+				continue
+			}
+			if paren := strings.LastIndexByte(line, '('); paren >= 0 {
+				d.step(extentOfName(line[:paren]))
+			} else {
+				d.step(extNone)
+			}
+		}
+	}
+	return byID
+}
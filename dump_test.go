@@ -0,0 +1,75 @@
+package glc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type dumpKey struct{}
+
+func TestDumpState(t *testing.T) {
+	stop := WatchLeaks(time.Hour, func(Leak) {})
+	defer stop()
+
+	ready, release := make(chan [2]uint64), make(chan struct{})
+	go func() {
+		WithContext(context.WithValue(context.Background(), dumpKey{}, "outer"), func() {
+			outer, _ := BindingID()
+			WithContext(context.WithValue(context.Background(), dumpKey{}, "inner"), func() {
+				stackit(20, func() {
+					inner, _ := BindingID()
+					ready <- [2]uint64{outer, inner}
+					<-release
+				})
+			})
+		})
+	}()
+	ids := <-ready
+	defer close(release)
+
+	var buf bytes.Buffer
+	if err := DumpState(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, id := range ids {
+		b := fmt.Sprintf("binding %d, age ", id)
+		i := strings.Index(out, b)
+		if i < 0 {
+			t.Fatalf("no %q in:\n%s", b, out)
+		}
+		entry, _, _ := strings.Cut(out[i:], "\n\nbinding")
+		if !strings.Contains(entry, "made by github.com/knusbaum/glc.TestDumpState") {
+			t.Errorf("binding %d: no creation site in:\n%s", id, entry)
+		}
+		if strings.Contains(entry, "goroutine not found") {
+			t.Errorf("binding %d: goroutine not found in:\n%s", id, entry)
+		}
+	}
+	if !strings.Contains(out, "inner") || !strings.Contains(out, "stackit") {
+		t.Errorf("no context or stack in:\n%s", out)
+	}
+}
+
+func TestGoroutineBindings(t *testing.T) {
+	// Far beyond any ID the counter will reach in a test.
+	const value = 1 << 62
+	WithContext(context.Background(), func() {
+		EncodeInto(value, func() {
+			WithContext(context.Background(), func() {
+				id, _ := BindingID()
+				stacks := goroutineBindings(allStacks())
+				if _, ok := stacks[id]; !ok {
+					t.Errorf("binding %d not found", id)
+				}
+				if _, ok := stacks[value]; ok {
+					t.Errorf("value %d was taken for a binding", uint64(value))
+				}
+			})
+		})
+	})
+}
@@ -2,11 +2,21 @@ package glc
 
 import "reflect"
 
+// encoderFingerprint describes the encoding this file implements: the version of
+// generate.sh that made it, the number of values each encoding function stands
+// for, and the number of those functions in an encoding.
+const encoderFingerprint = "generator=1 radix=256 bytes=8"
+
 //go:noinline
 func encend(cont func()) {
 	cont()
 }
 
+//go:noinline
+func encvalue(value uint64, cont func()) {
+	encstart(value, cont)
+}
+
 //go:noinline
 func encstart(id uint64, cont func()) {
 	var bs [8]byte
@@ -134164,7 +134174,7 @@ func encff(vnext []byte, cont func()) {
 }
 
 var encmap map[uintptr]byte
-var encstartpc, encendpc uintptr
+var encstartpc, encendpc, encvaluepc uintptr
 var enc00pc = uintptr(reflect.ValueOf(enc00).UnsafePointer())
 var enc01pc = uintptr(reflect.ValueOf(enc01).UnsafePointer())
 var enc02pc = uintptr(reflect.ValueOf(enc02).UnsafePointer())
@@ -134422,7 +134432,7 @@ var encfdpc = uintptr(reflect.ValueOf(encfd).UnsafePointer())
 var encfepc = uintptr(reflect.ValueOf(encfe).UnsafePointer())
 var encffpc = uintptr(reflect.ValueOf(encff).UnsafePointer())
 
-func valForPC(pc uintptr) (byte, bool) {
+func valForPCSwitch(pc uintptr) (byte, bool) {
 	switch pc {
 	case enc00pc:
 		return 0x00, true
@@ -134941,9 +134951,11 @@ func valForPC(pc uintptr) (byte, bool) {
 	}
 }
 func init() {
+	checkEncoder()
 	encmap = make(map[uintptr]byte)
 	encstartpc = uintptr(reflect.ValueOf(encstart).UnsafePointer())
 	encendpc = uintptr(reflect.ValueOf(encend).UnsafePointer())
+	encvaluepc = uintptr(reflect.ValueOf(encvalue).UnsafePointer())
 	encmap[uintptr(reflect.ValueOf(enc00).UnsafePointer())] = 0x00
 	encmap[uintptr(reflect.ValueOf(enc01).UnsafePointer())] = 0x01
 	encmap[uintptr(reflect.ValueOf(enc02).UnsafePointer())] = 0x02
@@ -135200,4 +135212,270 @@ func init() {
 	encmap[uintptr(reflect.ValueOf(encfd).UnsafePointer())] = 0xfd
 	encmap[uintptr(reflect.ValueOf(encfe).UnsafePointer())] = 0xfe
 	encmap[uintptr(reflect.ValueOf(encff).UnsafePointer())] = 0xff
+	checkEncmap()
+	initEncslice()
+	initExtents()
+}
+
+// initEncslice builds encslice from the encoding functions' entry points, each
+// at the index of the byte it encodes.
+func initEncslice() {
+	fillEncslice(&[256]uintptr{
+		enc00pc,
+		enc01pc,
+		enc02pc,
+		enc03pc,
+		enc04pc,
+		enc05pc,
+		enc06pc,
+		enc07pc,
+		enc08pc,
+		enc09pc,
+		enc0apc,
+		enc0bpc,
+		enc0cpc,
+		enc0dpc,
+		enc0epc,
+		enc0fpc,
+		enc10pc,
+		enc11pc,
+		enc12pc,
+		enc13pc,
+		enc14pc,
+		enc15pc,
+		enc16pc,
+		enc17pc,
+		enc18pc,
+		enc19pc,
+		enc1apc,
+		enc1bpc,
+		enc1cpc,
+		enc1dpc,
+		enc1epc,
+		enc1fpc,
+		enc20pc,
+		enc21pc,
+		enc22pc,
+		enc23pc,
+		enc24pc,
+		enc25pc,
+		enc26pc,
+		enc27pc,
+		enc28pc,
+		enc29pc,
+		enc2apc,
+		enc2bpc,
+		enc2cpc,
+		enc2dpc,
+		enc2epc,
+		enc2fpc,
+		enc30pc,
+		enc31pc,
+		enc32pc,
+		enc33pc,
+		enc34pc,
+		enc35pc,
+		enc36pc,
+		enc37pc,
+		enc38pc,
+		enc39pc,
+		enc3apc,
+		enc3bpc,
+		enc3cpc,
+		enc3dpc,
+		enc3epc,
+		enc3fpc,
+		enc40pc,
+		enc41pc,
+		enc42pc,
+		enc43pc,
+		enc44pc,
+		enc45pc,
+		enc46pc,
+		enc47pc,
+		enc48pc,
+		enc49pc,
+		enc4apc,
+		enc4bpc,
+		enc4cpc,
+		enc4dpc,
+		enc4epc,
+		enc4fpc,
+		enc50pc,
+		enc51pc,
+		enc52pc,
+		enc53pc,
+		enc54pc,
+		enc55pc,
+		enc56pc,
+		enc57pc,
+		enc58pc,
+		enc59pc,
+		enc5apc,
+		enc5bpc,
+		enc5cpc,
+		enc5dpc,
+		enc5epc,
+		enc5fpc,
+		enc60pc,
+		enc61pc,
+		enc62pc,
+		enc63pc,
+		enc64pc,
+		enc65pc,
+		enc66pc,
+		enc67pc,
+		enc68pc,
+		enc69pc,
+		enc6apc,
+		enc6bpc,
+		enc6cpc,
+		enc6dpc,
+		enc6epc,
+		enc6fpc,
+		enc70pc,
+		enc71pc,
+		enc72pc,
+		enc73pc,
+		enc74pc,
+		enc75pc,
+		enc76pc,
+		enc77pc,
+		enc78pc,
+		enc79pc,
+		enc7apc,
+		enc7bpc,
+		enc7cpc,
+		enc7dpc,
+		enc7epc,
+		enc7fpc,
+		enc80pc,
+		enc81pc,
+		enc82pc,
+		enc83pc,
+		enc84pc,
+		enc85pc,
+		enc86pc,
+		enc87pc,
+		enc88pc,
+		enc89pc,
+		enc8apc,
+		enc8bpc,
+		enc8cpc,
+		enc8dpc,
+		enc8epc,
+		enc8fpc,
+		enc90pc,
+		enc91pc,
+		enc92pc,
+		enc93pc,
+		enc94pc,
+		enc95pc,
+		enc96pc,
+		enc97pc,
+		enc98pc,
+		enc99pc,
+		enc9apc,
+		enc9bpc,
+		enc9cpc,
+		enc9dpc,
+		enc9epc,
+		enc9fpc,
+		enca0pc,
+		enca1pc,
+		enca2pc,
+		enca3pc,
+		enca4pc,
+		enca5pc,
+		enca6pc,
+		enca7pc,
+		enca8pc,
+		enca9pc,
+		encaapc,
+		encabpc,
+		encacpc,
+		encadpc,
+		encaepc,
+		encafpc,
+		encb0pc,
+		encb1pc,
+		encb2pc,
+		encb3pc,
+		encb4pc,
+		encb5pc,
+		encb6pc,
+		encb7pc,
+		encb8pc,
+		encb9pc,
+		encbapc,
+		encbbpc,
+		encbcpc,
+		encbdpc,
+		encbepc,
+		encbfpc,
+		encc0pc,
+		encc1pc,
+		encc2pc,
+		encc3pc,
+		encc4pc,
+		encc5pc,
+		encc6pc,
+		encc7pc,
+		encc8pc,
+		encc9pc,
+		enccapc,
+		enccbpc,
+		encccpc,
+		enccdpc,
+		enccepc,
+		enccfpc,
+		encd0pc,
+		encd1pc,
+		encd2pc,
+		encd3pc,
+		encd4pc,
+		encd5pc,
+		encd6pc,
+		encd7pc,
+		encd8pc,
+		encd9pc,
+		encdapc,
+		encdbpc,
+		encdcpc,
+		encddpc,
+		encdepc,
+		encdfpc,
+		ence0pc,
+		ence1pc,
+		ence2pc,
+		ence3pc,
+		ence4pc,
+		ence5pc,
+		ence6pc,
+		ence7pc,
+		ence8pc,
+		ence9pc,
+		enceapc,
+		encebpc,
+		encecpc,
+		encedpc,
+		enceepc,
+		encefpc,
+		encf0pc,
+		encf1pc,
+		encf2pc,
+		encf3pc,
+		encf4pc,
+		encf5pc,
+		encf6pc,
+		encf7pc,
+		encf8pc,
+		encf9pc,
+		encfapc,
+		encfbpc,
+		encfcpc,
+		encfdpc,
+		encfepc,
+		encffpc,
+	})
 }
@@ -0,0 +1,16 @@
+package glc
+
+import "context"
+
+// TestStore is a bindingStore with the methods of glctest.Store, so that
+// glctest.StoreSuite can run against the store glc actually uses. glctest
+// imports glc, so the suite has to run from package glc_test.
+type TestStore struct {
+	s bindingStore
+}
+
+func NewTestStore() *TestStore { return new(TestStore) }
+
+func (s *TestStore) Store(id uint64, ctx context.Context)   { s.s.Store(&binding{id: id, ctx: ctx}) }
+func (s *TestStore) Load(id uint64) (context.Context, bool) { return s.s.Load(id) }
+func (s *TestStore) Delete(id uint64)                       { s.s.Delete(id) }
@@ -0,0 +1,51 @@
+package glc
+
+import (
+	"expvar"
+	"sync"
+)
+
+var publishOnce sync.Once
+
+// PublishExpvar publishes glc's internal counters with the expvar package, as a
+// map named "glc", so that they show up on /debug/vars. It's safe to call more
+// than once. The counters are:
+//
+//	active_bindings          ActiveBindings()
+//	max_active_bindings      MaxActiveBindings()
+//	reclaimed_binding_slots  ReclaimedBindingSlots()
+//	overflow_bindings        bindings that didn't fit in the fixed-size table
+//	overflow_peak            how many such bindings the overflow has had room for
+//	decode_failures          DecodeFailures(kind), by kind
+//	leaks_reported           LeaksReported()
+//	dropped_bindings         DroppedBindings()
+//
+// Builds with the glc_debug tag also publish the decode statistics.
+func PublishExpvar() {
+	publishOnce.Do(func() {
+		expvar.Publish("glc", expvar.Func(func() any {
+			return metrics()
+		}))
+	})
+}
+
+// metrics returns the values published by PublishExpvar.
+func metrics() map[string]any {
+	overflow, peak := idmap.overflow.Len()
+	m := map[string]any{
+		"active_bindings":         ActiveBindings(),
+		"max_active_bindings":     MaxActiveBindings(),
+		"reclaimed_binding_slots": ReclaimedBindingSlots(),
+		"overflow_bindings":       overflow,
+		"overflow_peak":           peak,
+		"leaks_reported":          LeaksReported(),
+		"dropped_bindings":        DroppedBindings(),
+	}
+	failures := make(map[string]int64)
+	for k := DecodeFailureKind(0); k < numDecodeFailureKinds; k++ {
+		failures[k.String()] = DecodeFailures(k)
+	}
+	m["decode_failures"] = failures
+	debugMetrics(m)
+	return m
+}
@@ -0,0 +1,33 @@
+package glc
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"testing"
+)
+
+func TestPublishExpvar(t *testing.T) {
+	PublishExpvar()
+	PublishExpvar()
+	v := expvar.Get("glc")
+	if v == nil {
+		t.Fatal("glc isn't published")
+	}
+	WithContext(context.Background(), func() {
+		var m struct {
+			Active         int              `json:"active_bindings"`
+			MaxActive      int              `json:"max_active_bindings"`
+			DecodeFailures map[string]int64 `json:"decode_failures"`
+		}
+		if err := json.Unmarshal([]byte(v.String()), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.Active < 1 || m.MaxActive < m.Active {
+			t.Errorf("got %+v", m)
+		}
+		if _, ok := m.DecodeFailures[NoBinding.String()]; !ok {
+			t.Errorf("no %q in decode failures %v", NoBinding, m.DecodeFailures)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package glc
+
+import (
+	"math/bits"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The values held by exttable. 1 through 256 stand for the encoding functions
+// for the bytes 0 through 255.
+const (
+	extNone  = 0
+	extStart = 257
+	extEnd   = 258
+	extValue = 259
+)
+
+// exttable records which encoding function, if any, contains each address
+// between extbase and the end of the last encoding function. It's indexed by the
+// address's offset from extbase, shifted right by extshift.
+//
+// The shift is chosen so that every function boundary falls on a multiple of
+// 1<<extshift, so no slot straddles two functions and a lookup is exact.
+//
+// exttable is nil if initExtents couldn't build it, in which case the decoder
+// falls back to asking the runtime about each address.
+var exttable []uint16
+var extbase uintptr
+var extshift uint
+
+// maxExtTable is the largest exttable initExtents is willing to build. It's
+// several times larger than the table needs to be with any linker we know of.
+const maxExtTable = 1 << 20
+
+// initExtents builds exttable. The generated init calls it once encmap is
+// filled in.
+func initExtents() {
+	if purego {
+		// Nothing uses it.
+		return
+	}
+	type extent struct {
+		entry, end uintptr
+		v          uint16
+	}
+	var exts []extent
+	for pc, v := range encmap {
+		exts = append(exts, extent{entry: pc, v: uint16(v) + 1})
+	}
+	exts = append(exts,
+		extent{entry: encstartpc, v: extStart},
+		extent{entry: encendpc, v: extEnd},
+		extent{entry: uintptr(reflect.ValueOf(encvalue).UnsafePointer()), v: extValue},
+	)
+	sort.Slice(exts, func(i, j int) bool { return exts[i].entry < exts[j].entry })
+
+	// The runtime doesn't tell us where a function ends, only which function
+	// an address is in. Each function ends where the next one in the binary
+	// starts, and that is usually the next encoding function. If it isn't,
+	// the function we land in instead is closer, and we try again from there.
+	for i := range exts {
+		end := exts[i].entry + 1
+		if i+1 < len(exts) {
+			end = exts[i+1].entry
+		}
+		for {
+			f := runtime.FuncForPC(end - 1)
+			if f == nil {
+				return
+			}
+			if f.Entry() == exts[i].entry {
+				break
+			}
+			if f.Entry() <= exts[i].entry {
+				// Nowhere to go. Give up rather than guess.
+				return
+			}
+			end = f.Entry()
+		}
+		if i+1 == len(exts) {
+			// The last function has nothing after it to go looking for, so
+			// search forward for the first address outside of it.
+			end = firstOutside(exts[i].entry)
+		}
+		exts[i].end = end
+	}
+
+	base := exts[0].entry
+	var offsets uintptr
+	for _, e := range exts {
+		offsets |= (e.entry - base) | (e.end - base)
+	}
+	shift := uint(bits.TrailingZeros64(uint64(offsets)))
+	size := (exts[len(exts)-1].end - base) >> shift
+	if size > maxExtTable {
+		return
+	}
+	table := make([]uint16, size)
+	for _, e := range exts {
+		for pc := e.entry; pc < e.end; pc += 1 << shift {
+			table[(pc-base)>>shift] = e.v
+		}
+	}
+	exttable, extbase, extshift = table, base, shift
+}
+
+// firstOutside returns the first address after entry that isn't within the
+// function starting at entry.
+func firstOutside(entry uintptr) uintptr {
+	inside := func(pc uintptr) bool {
+		f := runtime.FuncForPC(pc)
+		return f != nil && f.Entry() == entry
+	}
+	lo, hi := entry, entry+1
+	for inside(hi) {
+		lo, hi = hi, hi+(hi-entry)
+	}
+	// lo is inside and hi is not.
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if inside(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// extentAt returns what exttable says about pc. It must only be called when
+// exttable is not nil.
+func extentAt(pc uintptr) uint16 {
+	// If pc is below extbase, the subtraction wraps around and the index is
+	// out of range.
+	if i := (pc - extbase) >> extshift; i < uintptr(len(exttable)) {
+		return exttable[i]
+	}
+	return extNone
+}
+
+// encPrefix is what the names of the encoding functions start with.
+var encPrefix = strings.TrimSuffix(runtime.FuncForPC(reflect.ValueOf(encstart).Pointer()).Name(), "encstart")
+
+// extentOfName does for a function, named as runtime.Frame.Function and
+// tracebacks name it, what extentAt does for an address.
+func extentOfName(name string) uint16 {
+	if !strings.HasPrefix(name, encPrefix) {
+		return extNone
+	}
+	switch name = name[len(encPrefix):]; name {
+	case "encstart":
+		return extStart
+	case "encend":
+		return extEnd
+	case "encvalue":
+		return extValue
+	}
+	if len(name) == 5 && strings.HasPrefix(name, "enc") {
+		if v, err := strconv.ParseUint(name[3:], 16, 8); err == nil {
+			return uint16(v) + 1
+		}
+	}
+	return extNone
+}
@@ -0,0 +1,66 @@
+package glc
+
+import (
+	"context"
+	"runtime"
+	"testing"
+)
+
+// extentByRuntime is what extentAt should say about pc, worked out the slow way.
+func extentByRuntime(pc uintptr) uint16 {
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return extNone
+	}
+	switch e := f.Entry(); e {
+	case encstartpc:
+		return extStart
+	case encendpc:
+		return extEnd
+	case encvaluepc:
+		return extValue
+	default:
+		if v, ok := encmap[e]; ok {
+			return uint16(v) + 1
+		}
+		return extNone
+	}
+}
+
+func TestExtents(t *testing.T) {
+	if purego {
+		t.Skip("purego builds have no extent table")
+	}
+	if exttable == nil {
+		t.Fatal("no extent table")
+	}
+	end := extbase + uintptr(len(exttable))<<extshift
+	// Every address would take a while. An odd stride hits every offset
+	// within the slots eventually.
+	for pc := extbase - 1000; pc < end+1000; pc += 7 {
+		if got, want := extentAt(pc), extentByRuntime(pc); got != want {
+			t.Fatalf("extentAt(%#x) = %d, want %d", pc, got, want)
+		}
+	}
+}
+
+func TestScanRuntime(t *testing.T) {
+	table := exttable
+	exttable = nil
+	defer func() { exttable = table }()
+
+	for _, depth := range []int{0, decodeChunk, 1000} {
+		WithContext(context.WithValue(context.Background(), "depth", depth), func() {
+			EncodeInto(uint64(depth), func() {
+				stackit(depth, func() {
+					if ctx := GetContext(); ctx == nil || ctx.Value("depth") != depth {
+						t.Errorf("depth %d: got wrong context %v", depth, ctx)
+					}
+					if v, ok := DecodeValue(); !ok || v != uint64(depth) {
+						t.Errorf("depth %d: got value %d, %v", depth, v, ok)
+					}
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package glc
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// DecodeFailureKind says why a call to `GetContext` came back empty.
+type DecodeFailureKind int
+
+const (
+	// NoBinding means there was no binding on the stack. This is what
+	// happens when GetContext is called outside any WithContext scope, which
+	// is often intended.
+	NoBinding DecodeFailureKind = iota
+	// UnknownID means there was a binding on the stack, but its ID wasn't in
+	// the binding table. This shouldn't happen.
+	UnknownID
+	// DecoderMismatch means the decoder and the reference decoder disagreed.
+	// It's only checked for in builds with the glc_debug tag, or when
+	// GLCDEBUG=decodecheck=1 is set.
+	DecoderMismatch
+	// TruncatedStack means the stack ended part way through an encoding, so
+	// the binding couldn't be read. This shouldn't happen either.
+	TruncatedStack
+	// Dropped means the innermost binding was dropped because the limit
+	// set by SetMaxBindings had been reached.
+	Dropped
+
+	numDecodeFailureKinds
+)
+
+func (k DecodeFailureKind) String() string {
+	switch k {
+	case NoBinding:
+		return "no binding"
+	case UnknownID:
+		return "unknown ID"
+	case DecoderMismatch:
+		return "decoder mismatch"
+	case TruncatedStack:
+		return "truncated stack"
+	case Dropped:
+		return "binding dropped"
+	}
+	return "unknown failure"
+}
+
+// DecodeFailure describes a failed decode.
+type DecodeFailure struct {
+	Kind DecodeFailureKind
+	// ID is the ID that was decoded, for UnknownID and DecoderMismatch.
+	ID uint64
+	// Stack is the stack trace of the goroutine that failed to decode, as
+	// formatted by debug.Stack.
+	Stack []byte
+}
+
+// DecodeError is the error `GetContextErr` returns when it finds no context.
+type DecodeError struct {
+	Kind DecodeFailureKind
+	// ID is the ID that was decoded, for UnknownID.
+	ID uint64
+}
+
+func (e *DecodeError) Error() string {
+	if e.Kind == UnknownID {
+		return fmt.Sprintf("glc: no context: %v %d", e.Kind, e.ID)
+	}
+	return "glc: no context: " + e.Kind.String()
+}
+
+var failureCounts [numDecodeFailureKinds]atomic.Int64
+
+// DecodeFailures returns the number of decodes that have failed in the way
+// given by `kind` since the program started.
+func DecodeFailures(kind DecodeFailureKind) int64 {
+	if kind < 0 || kind >= numDecodeFailureKinds {
+		return 0
+	}
+	return failureCounts[kind].Load()
+}
+
+type failureSampler struct {
+	every int64
+	n     atomic.Int64
+	hook  func(DecodeFailure)
+}
+
+var sampler atomic.Pointer[failureSampler]
+
+// SampleDecodeFailures calls `hook` with one in every `every` failed decodes,
+// with the stack trace of the goroutine it happened on. `hook` is called on
+// that goroutine, from within GetContext. Capturing the stack is expensive, so
+// programs that call GetContext outside of any binding as a matter of course
+// will want a large `every`.
+//
+// Calling SampleDecodeFailures again replaces the hook. An `every` of zero or
+// less, or a nil `hook`, stops sampling.
+func SampleDecodeFailures(every int, hook func(DecodeFailure)) {
+	if every <= 0 || hook == nil {
+		sampler.Store(nil)
+		return
+	}
+	sampler.Store(&failureSampler{every: int64(every), hook: hook})
+}
+
+// decodeFailed records a failed decode.
+func decodeFailed(kind DecodeFailureKind, id uint64) {
+	failureCounts[kind].Add(1)
+	s := sampler.Load()
+	if s == nil || s.n.Add(1)%s.every != 0 {
+		return
+	}
+	s.hook(DecodeFailure{Kind: kind, ID: id, Stack: debug.Stack()})
+}
@@ -0,0 +1,81 @@
+package glc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestDecodeFailures(t *testing.T) {
+	var sampled []DecodeFailure
+	SampleDecodeFailures(2, func(f DecodeFailure) {
+		sampled = append(sampled, f)
+	})
+	defer SampleDecodeFailures(0, nil)
+
+	before := DecodeFailures(NoBinding)
+	for i := 0; i < 4; i++ {
+		GetContext()
+	}
+	if n := DecodeFailures(NoBinding) - before; n != 4 {
+		t.Errorf("counted %d failures, want 4", n)
+	}
+	if len(sampled) != 2 {
+		t.Fatalf("sampled %d failures, want 2", len(sampled))
+	}
+	if f := sampled[0]; f.Kind != NoBinding || !bytes.Contains(f.Stack, []byte("TestDecodeFailures")) {
+		t.Errorf("got %v failure with stack:\n%s", f.Kind, f.Stack)
+	}
+
+	sampled = nil
+	before = DecodeFailures(NoBinding)
+	WithContext(context.Background(), func() {
+		GetContext()
+		GetContext()
+	})
+	if n := DecodeFailures(NoBinding) - before; n != 0 || len(sampled) != 0 {
+		t.Errorf("counted %d failures and sampled %d with a binding", n, len(sampled))
+	}
+
+	// A binding missing from the table.
+	before = DecodeFailures(UnknownID)
+	encstart(1<<63, func() {
+		GetContext()
+		GetContext()
+	})
+	if n := DecodeFailures(UnknownID) - before; n != 2 {
+		t.Errorf("counted %d unknown IDs, want 2", n)
+	}
+	if len(sampled) != 1 || sampled[0].Kind != UnknownID || sampled[0].ID != 1<<63 {
+		t.Errorf("got samples %+v", sampled)
+	}
+}
+
+func TestGetContextErr(t *testing.T) {
+	var de *DecodeError
+	if ctx, err := GetContextErr(); ctx != nil || !errors.As(err, &de) || de.Kind != NoBinding {
+		t.Errorf("unbound: got %v, %v", ctx, err)
+	}
+	WithContext(context.Background(), func() {
+		if ctx, err := GetContextErr(); ctx == nil || err != nil {
+			t.Errorf("bound: got %v, %v", ctx, err)
+		}
+	})
+	encstart(1<<63, func() {
+		if ctx, err := GetContextErr(); ctx != nil || !errors.As(err, &de) || de.Kind != UnknownID || de.ID != 1<<63 {
+			t.Errorf("unknown ID: got %v, %v", ctx, err)
+		}
+	})
+
+	// Real stacks don't end in the middle of an encoding, so check that the
+	// decoder notices when a synthetic one does.
+	for _, prog := range [][]byte{{2, 4, 1}, {0, 0, 0, 0, 0, 0, 0, 0, 1}} {
+		stack := fuzzStack(prog)
+		stack = stack[:len(stack)-1]
+		d := idDecoder{want: encodingID}
+		if _, _, done := d.scan(stack); done || !d.decoding {
+			t.Errorf("%v: done %t, decoding %t; want a truncated encoding", prog, done, d.decoding)
+		}
+	}
+}
@@ -1,9 +1,21 @@
 #!/usr/bin/env bash
 
+# generatorVersion changes whenever this script changes what it generates in a
+# way the decoder has to know about. It goes into encoderFingerprint, which the
+# decoder checks at init.
+generatorVersion=1
+
 echo package glc
 
 echo 'import "reflect"'
 
+cat <<EOF
+// encoderFingerprint describes the encoding this file implements: the version of
+// generate.sh that made it, the number of values each encoding function stands
+// for, and the number of those functions in an encoding.
+const encoderFingerprint = "generator=${generatorVersion} radix=256 bytes=8"
+EOF
+
 cat <<EOF
 //go:noinline
 func encend(cont func()) {
@@ -11,6 +23,13 @@ func encend(cont func()) {
 }
 EOF
 
+cat <<EOF
+//go:noinline
+func encvalue(value uint64, cont func()) {
+     encstart(value, cont)
+}
+EOF
+
 cat <<EOF
 //go:noinline
 func encstart(id uint64, cont func()) {
@@ -65,7 +84,7 @@ done;
 cat <<EOF
 
 var encmap map[uintptr]byte
-var encstartpc, encendpc uintptr
+var encstartpc, encendpc, encvaluepc uintptr
 EOF
 
 for ii in {0..9} {a..f}; do
@@ -77,7 +96,7 @@ EOF
 done;
 
 cat <<EOF
-func valForPC(pc uintptr) (byte, bool) {
+func valForPCSwitch(pc uintptr) (byte, bool) {
      switch pc {
 EOF
 for ii in {0..9} {a..f}; do
@@ -99,9 +118,11 @@ EOF
 
 cat <<EOF
 func init() {
+     checkEncoder()
      encmap = make(map[uintptr]byte)
      encstartpc = uintptr(reflect.ValueOf(encstart).UnsafePointer())
      encendpc = uintptr(reflect.ValueOf(encend).UnsafePointer())
+     encvaluepc = uintptr(reflect.ValueOf(encvalue).UnsafePointer())
 EOF
 for ii in {0..9} {a..f}; do
     for jj in {0..9} {a..f}; do
@@ -111,5 +132,24 @@ EOF
 
     done;
 done;
+ echo '     checkEncmap()'
+ echo '     initEncslice()'
+ echo '     initExtents()'
  echo '}'
+
+cat <<EOF
+// initEncslice builds encslice from the encoding functions' entry points, each
+// at the index of the byte it encodes.
+func initEncslice() {
+     fillEncslice(&[256]uintptr{
+EOF
+for ii in {0..9} {a..f}; do
+    for jj in {0..9} {a..f}; do
+	cat <<EOF
+	enc${ii}${jj}pc,
+EOF
+    done;
+done;
+echo '     })'
+echo '}'
  
@@ -0,0 +1,50 @@
+#!/usr/bin/env bash
+
+# gentags.sh generates tags.go, the functions StackTag calls through. There is
+# one function for each character a tag can hold, named tag_ and the character.
+
+chars=({a..z} {A..Z} {0..9} _)
+
+echo package glc
+
+# dispatch prints the switch calling the function for the last character of
+# rest, with the rest of rest, or f if there is nothing left.
+dispatch() {
+    cat <<EOF
+    if len(rest) == 0 {
+        f()
+        return
+    }
+    switch rest[len(rest)-1] {
+EOF
+    for c in "${chars[@]}"; do
+	if [ "$c" = _ ]; then
+	    continue
+	fi
+	cat <<EOF
+    case '${c}':
+        tag_${c}(rest[:len(rest)-1], f)
+EOF
+    done
+    cat <<EOF
+    default:
+        tag__(rest[:len(rest)-1], f)
+    }
+EOF
+}
+
+cat <<EOF
+//go:noinline
+func tagstart(rest string, f func()) {
+EOF
+dispatch
+echo "}"
+
+for c in "${chars[@]}"; do
+    cat <<EOF
+//go:noinline
+func tag_${c}(rest string, f func()) {
+EOF
+    dispatch
+    echo "}"
+done
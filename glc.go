@@ -2,7 +2,8 @@ package glc
 
 import (
 	"context"
-	"sync"
+	"runtime"
+	"runtime/pprof"
 	"sync/atomic"
 )
 
@@ -12,52 +13,175 @@ import (
 //
 // The dynamic binding does not cross goroutine boundaries, so these bindings are
 // not visible to functions called with the `go` keyword.
+//
+// If `SetMaxBindings` has set a limit and it has been reached, `f` is executed
+// with no context bound at all. Calls to `GetContext` within it return nil.
 func WithContext(ctx context.Context, f func()) {
-	id := nextID()
-	idmap.Store(id, ctx)
-	defer idmap.Delete(id)
-	encstart(id, f)
+	if overBindingLimit() {
+		dropBinding(f)
+		return
+	}
+	bind(ctx, f, 1)
+}
+
+// bind binds ctx while executing f. skip is the number of frames between bind
+// and the caller of the exported function that called it.
+func bind(ctx context.Context, f func(), skip int) {
+	b := &binding{id: nextID(), ctx: ctx}
+	if atomic.LoadInt32(&leakWatchers) > 0 {
+		b.created.Store(sinceEpoch())
+		// Skip runtime.Callers and bind.
+		runtime.Callers(2+skip, b.site[:])
+	}
+	if lctx, ok := label(ctx, b.id); ok {
+		b.ctx = lctx
+		defer pprof.SetGoroutineLabels(ctx)
+	} else if rctx, ok := goroutineLabel(ctx, b.id); ok {
+		defer pprof.SetGoroutineLabels(rctx)
+	}
+	if tctx, t := task(b.ctx, 1+skip); t != nil {
+		b.ctx = tctx
+		defer t.End()
+	}
+	if r := panicReporter.Load(); r != nil {
+		defer reportPanic(b, *r)
+	}
+	idmap.Store(b)
+	defer idmap.Delete(b.id)
+	if unbind := bindHooks(b.id, b.ctx); unbind != nil {
+		defer unbind()
+	}
+	encstart(b.id, f)
 }
 
 // GetContext returns the `context.Context` currently bound to the stack by
 // `WithContext`.
 func GetContext() context.Context {
-	id, ok := lastID()
+	ctx, _, _, _ := lookup()
+	return ctx
+}
+
+// GetContextErr is `GetContext`, but says why it found no context. The error is
+// always a *DecodeError. A DecodeError with Kind NoBinding just means
+// GetContextErr was called outside of any `WithContext` scope. Any other kind
+// means something went wrong.
+func GetContextErr() (context.Context, error) {
+	ctx, ok, id, miss := lookup()
 	if !ok {
-		return nil
+		return nil, &DecodeError{Kind: miss, ID: id}
 	}
-	ctx, ok := idmap.Load(id)
+	return ctx, nil
+}
+
+// lookup finds the context bound by the innermost live binding on the stack.
+// If there isn't one, it records the failure, and returns why.
+func lookup() (ctx context.Context, ok bool, id uint64, miss DecodeFailureKind) {
+	ctx, ok, id, miss = find()
 	if !ok {
-		return nil
+		decodeFailed(miss, id)
 	}
-	return ctx
+	return ctx, ok, id, miss
 }
 
-var id uint64
-var idmap syncMap[uint64, context.Context]
+// find is lookup without the record keeping.
+func find() (ctx context.Context, ok bool, id uint64, miss DecodeFailureKind) {
+	id, ok, miss = decode(encodingID, func(id uint64) bool {
+		if _, hidden := hiddenKind(id); hidden {
+			return true
+		}
+		var ok bool
+		ctx, ok = idmap.Load(id)
+		return ok
+	})
+	if kind, hidden := hiddenKind(id); ok && hidden {
+		return nil, false, 0, kind
+	}
+	if !ok {
+		return nil, false, id, miss
+	}
+	return ctx, true, id, 0
+}
 
-func nextID() uint64 {
-	return atomic.AddUint64(&id, 1)
+// BindingID returns the ID of the binding made by the innermost `WithContext`
+// scope on the stack. IDs are unique for the life of the program, so they can be
+// used to tell which scope a goroutine is running under. The boolean is false if
+// there is no such scope.
+func BindingID() (uint64, bool) {
+	id, ok, _ := decode(encodingID, func(id uint64) bool {
+		if _, hidden := hiddenKind(id); hidden {
+			return true
+		}
+		_, ok := idmap.Load(id)
+		return ok
+	})
+	if _, hidden := hiddenKind(id); hidden {
+		return 0, false
+	}
+	return id, ok
 }
 
-type syncMap[T, U any] struct {
-	m sync.Map
+// ActiveBindings returns the number of `WithContext` scopes that are currently
+// live, across all goroutines.
+func ActiveBindings() int {
+	return int(idmap.live.Load())
 }
 
-func (s *syncMap[T, U]) Store(key T, value U) {
-	s.m.Store(key, value)
+// MaxActiveBindings returns the largest number of `WithContext` scopes that have
+// been live at once since the program started.
+func MaxActiveBindings() int {
+	return int(idmap.maxLive.Load())
 }
 
-func (s *syncMap[T, U]) Load(key T) (U, bool) {
-	var ret U
-	v, ok := s.m.Load(key)
-	if !ok {
-		return ret, ok
-	}
-	ret = v.(U)
-	return ret, ok
+// ReclaimedBindingSlots returns the number of entries' worth of memory given back
+// by shrinking the table of bindings after it has grown and emptied out again.
+// The table only grows beyond a fixed size when bindings live long enough for
+// thousands of others to be made during their lifetime.
+func ReclaimedBindingSlots() int {
+	return int(reclaimedSlots.Load())
+}
+
+// EncodeInto executes the function `f` with `value` encoded into the stack.
+// Calls to `DecodeValue` within `f` or the functions which `f` calls will return
+// `value`.
+//
+// Unlike `WithContext`, nothing is stored outside of the stack, so `DecodeValue`
+// never touches a map. Encodings made by `EncodeInto` are invisible to
+// `GetContext`, and bindings made by `WithContext` are invisible to
+// `DecodeValue`.
+func EncodeInto(value uint64, f func()) {
+	encvalue(value, f)
+}
+
+// DecodeValue returns the value most recently encoded into the stack by
+// `EncodeInto`. The boolean is false if there is no such value.
+func DecodeValue() (uint64, bool) {
+	return lastValue()
 }
 
-func (s *syncMap[T, U]) Delete(key T) {
-	s.m.Delete(key)
+// MaxReservedFrames is the largest number of stack frames that `WithContext` or
+// `EncodeInto` place between their caller and `f`. Each of these frames is small,
+// holding little more than the remainder of the encoding and `f` itself.
+const MaxReservedFrames = encodingFrames + 2
+
+// ReservedFrames returns the number of stack frames that `WithContext` or
+// `WithContextErr` place between their caller and `f` when binding `id`, or that
+// `EncodeInto` places there when encoding `id`.
+//
+// Every ID currently takes the same number of frames, but this may change if the
+// encoding learns to use fewer frames for small IDs. It will never exceed
+// `MaxReservedFrames`.
+func ReservedFrames(id uint64) int {
+	// The encoding, plus WithContext and bind, or EncodeInto and encvalue.
+	return encodingFrames + 2
+}
+
+// encodingFrames is the number of frames in an encoding: encstart, one frame for
+// each byte of the ID, and encend.
+const encodingFrames = 10
+
+var id uint64
+var idmap bindingStore
+
+func nextID() uint64 {
+	return atomic.AddUint64(&id, 1)
 }
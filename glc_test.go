@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"runtime"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -30,6 +33,121 @@ func TestContext(t *testing.T) {
 	})
 }
 
+func TestDeepStack(t *testing.T) {
+	// Walk the binding far enough away from GetContext that the encoding
+	// straddles, and then lies entirely beyond, the first decode chunk. Each
+	// depth is decoded starting from the chunk, from a buffer too small for
+	// it, and from one large enough.
+	defer atomic.StoreInt64(&depthHint, atomic.LoadInt64(&depthHint))
+	for _, hint := range []int64{0, decodeChunk + 1, 10000} {
+		for _, depth := range []int{0, 1, decodeChunk - 10, decodeChunk - 5, decodeChunk, decodeChunk + 5, 1000, 5000} {
+			atomic.StoreInt64(&depthHint, hint)
+			WithContext(context.WithValue(context.Background(), "depth", depth), func() {
+				stackit(depth, func() {
+					ctx := GetContext()
+					if ctx == nil || ctx.Value("depth") != depth {
+						t.Errorf("hint %d, depth %d: got wrong context %v", hint, depth, ctx)
+					}
+				})
+			})
+		}
+	}
+}
+
+func TestDeepStackNoContext(t *testing.T) {
+	stackit(1000, func() {
+		if ctx := GetContext(); ctx != nil {
+			t.Errorf("expected nil context, got %v", ctx)
+		}
+	})
+}
+
+func TestActiveBindings(t *testing.T) {
+	before, max := ActiveBindings(), MaxActiveBindings()
+	var nest func(n int)
+	nest = func(n int) {
+		if got := ActiveBindings(); got != before+n {
+			t.Errorf("%d bindings deep: ActiveBindings() = %d, want %d", n, got, before+n)
+		}
+		if n < 10 {
+			WithContext(context.Background(), func() { nest(n + 1) })
+		}
+	}
+	nest(0)
+	if got := ActiveBindings(); got != before {
+		t.Errorf("ActiveBindings() = %d after all scopes exited, want %d", got, before)
+	}
+	want := before + 10
+	if max > want {
+		want = max
+	}
+	if got := MaxActiveBindings(); got != want {
+		t.Errorf("MaxActiveBindings() = %d, want %d", got, want)
+	}
+}
+
+func TestEncodeInto(t *testing.T) {
+	if _, ok := DecodeValue(); ok {
+		t.Error("expected no value")
+	}
+	EncodeInto(0xfedcba9876543210, func() {
+		v, ok := DecodeValue()
+		if !ok || v != 0xfedcba9876543210 {
+			t.Errorf("got %#x, %v", v, ok)
+		}
+		if ctx := GetContext(); ctx != nil {
+			t.Errorf("expected nil context, got %v", ctx)
+		}
+		WithContext(context.WithValue(context.Background(), "foo", "bar"), func() {
+			// The binding made by WithContext sits between us and the value.
+			v, ok := DecodeValue()
+			if !ok || v != 0xfedcba9876543210 {
+				t.Errorf("got %#x, %v", v, ok)
+			}
+			EncodeInto(7, func() {
+				v, ok := DecodeValue()
+				if !ok || v != 7 {
+					t.Errorf("got %#x, %v", v, ok)
+				}
+				if GetContext().Value("foo") != "bar" {
+					t.Error("expected context to survive EncodeInto")
+				}
+			})
+		})
+	})
+}
+
+//go:noinline
+func frames() int {
+	var pcs [1000]uintptr
+	return runtime.Callers(0, pcs[:])
+}
+
+func TestReservedFrames(t *testing.T) {
+	for _, id := range []uint64{0, 1, 0xff, 0x1234, 0xffffffffffffffff} {
+		outer := frames()
+		// One less for the frame of the closure we're measuring from.
+		encstart(id, func() {
+			if got := frames() - outer - 1; got != encodingFrames {
+				t.Errorf("encstart(%#x) used %d frames, expected %d", id, got, encodingFrames)
+			}
+		})
+		EncodeInto(id, func() {
+			if got := frames() - outer - 1; got > MaxReservedFrames {
+				t.Errorf("EncodeInto(%#x) used %d frames, more than the maximum %d", id, got, MaxReservedFrames)
+			}
+		})
+	}
+	outer := frames()
+	WithContext(context.Background(), func() {
+		got := frames() - outer - 1
+		id, _ := lastID()
+		if got != ReservedFrames(id) || got > MaxReservedFrames {
+			t.Errorf("WithContext used %d frames, expected %d", got, ReservedFrames(id))
+		}
+	})
+}
+
 func BenchmarkWithContext(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		WithContext(context.WithValue(context.Background(), "foo", "bar"), func() {
@@ -90,6 +208,17 @@ func BenchmarkContention(b *testing.B) {
 	wg.Wait()
 }
 
+func BenchmarkContentionWithContext(b *testing.B) {
+	b.SetParallelism(50)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			WithContext(context.WithValue(context.Background(), "foo", "bar"), func() {
+				GetContext()
+			})
+		}
+	})
+}
+
 //go:noinline
 func stackit(n int, f func()) {
 	if n > 0 {
@@ -146,3 +275,88 @@ func BenchmarkDeepStack(b *testing.B) {
 	})
 	fmt.Fprintf(io.Discard, "X: %d\n", x)
 }
+
+func BenchmarkGetContextDepth(b *testing.B) {
+	for _, depth := range []int{0, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("depth=%d", depth), func(b *testing.B) {
+			WithContext(context.WithValue(context.Background(), "foo", "bar"), func() {
+				stackit(depth, func() {
+					b.ResetTimer()
+					for i := 0; i < b.N; i++ {
+						GetContext()
+					}
+				})
+			})
+		})
+	}
+}
+
+func BenchmarkNextID(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			nextID()
+		}
+	})
+}
+
+func TestNextIDUnique(t *testing.T) {
+	const goroutines, ids = 16, 10000
+	var mu sync.Mutex
+	seen := make(map[uint64]bool)
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			defer wg.Done()
+			mine := make([]uint64, ids)
+			for i := range mine {
+				mine[i] = nextID()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, id := range mine {
+				if id == 0 || seen[id] {
+					t.Errorf("nextID() returned %d twice", id)
+				}
+				seen[id] = true
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestEncoderFingerprint(t *testing.T) {
+	if want := fmt.Sprintf("bytes=%d", encodingBytes); !strings.Contains(decoderFingerprint, want) {
+		t.Errorf("decoderFingerprint %q doesn't say %s", decoderFingerprint, want)
+	}
+	encmap[0] = 0
+	defer delete(encmap, 0)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("checkEncmap didn't notice an extra encoding function")
+		}
+	}()
+	checkEncmap()
+}
+
+// TestDeferDuringPanic checks that a deferred call run by a panic unwinding out
+// of a scope sees the enclosing scope's context, even though the inner scope's
+// encoding is still on the stack underneath it.
+func TestDeferDuringPanic(t *testing.T) {
+	outer := context.WithValue(context.Background(), "scope", "outer")
+	WithContext(outer, func() {
+		want, _ := BindingID()
+		defer func() {
+			recover()
+			if ctx := GetContext(); ctx != outer {
+				t.Errorf("got context %v, want %v", ctx, outer)
+			}
+			if id, _ := BindingID(); id != want {
+				t.Errorf("got binding %d, want %d", id, want)
+			}
+		}()
+		WithContext(context.Background(), func() {
+			panic("boom")
+		})
+	})
+}
@@ -0,0 +1,54 @@
+// Package glcctx adapts glc.WithContext bindings to APIs that want a
+// context.Context up front.
+package glcctx
+
+import (
+	"context"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+// Dynamic returns a context.Context whose methods delegate to whatever context
+// is bound on the calling goroutine at the moment of each call, or to
+// context.Background() if there is none. It can be handed to APIs that keep the
+// context they were constructed with, so that they follow the scope they are
+// used in rather than the one they were made in.
+//
+// Each call decodes the stack, so Dynamic is only as cheap as glc.GetContext.
+// Because the answer depends on the calling goroutine, a channel returned by
+// Done is only meaningful to the goroutine that asked for it. Contexts derived
+// from Dynamic with context.WithCancel and friends take their parent's Done
+// channel when they are made, and so follow the scope they were made in.
+func Dynamic() context.Context {
+	return dynamic{}
+}
+
+type dynamic struct{}
+
+func current() context.Context {
+	if ctx := glc.GetContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+func (dynamic) Deadline() (time.Time, bool) {
+	return current().Deadline()
+}
+
+func (dynamic) Done() <-chan struct{} {
+	return current().Done()
+}
+
+func (dynamic) Err() error {
+	return current().Err()
+}
+
+func (dynamic) Value(key any) any {
+	return current().Value(key)
+}
+
+func (dynamic) String() string {
+	return "glcctx.Dynamic"
+}
@@ -0,0 +1,33 @@
+package glcctx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestDynamic(t *testing.T) {
+	ctx := Dynamic()
+	if ctx.Done() != nil || ctx.Err() != nil || ctx.Value(key{}) != nil {
+		t.Errorf("unbound Dynamic doesn't behave like context.Background")
+	}
+
+	c1, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, 1))
+	cancel()
+	glc.WithContext(c1, func() {
+		if ctx.Value(key{}) != 1 || ctx.Err() != context.Canceled {
+			t.Errorf("got value %v, err %v; want 1, %v", ctx.Value(key{}), ctx.Err(), context.Canceled)
+		}
+		glc.WithContext(context.WithValue(context.Background(), key{}, 2), func() {
+			if ctx.Value(key{}) != 2 || ctx.Err() != nil {
+				t.Errorf("got value %v, err %v; want 2, nil", ctx.Value(key{}), ctx.Err())
+			}
+		})
+		if ctx.Value(key{}) != 1 {
+			t.Errorf("got value %v after inner scope, want 1", ctx.Value(key{}))
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// Package glcerrors wraps errors with the identity of the glc.WithContext scope
+// they were made under, so that errors logged far from where they were made
+// still say which request they belong to.
+package glcerrors
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/knusbaum/glc"
+)
+
+// Error is an error annotated with the binding it was wrapped under. Use
+// errors.As to get at it.
+type Error struct {
+	// Err is the wrapped error.
+	Err error
+	// ID is the ID of the binding, as returned by glc.BindingID.
+	ID uint64
+	// Values holds whatever the extractor set by SetExtractor returned for the
+	// bound context. It is nil if there is no extractor.
+	Values map[string]string
+}
+
+// Error returns the wrapped error's message, followed by the binding ID and
+// values in brackets, in key order:
+//
+//	connection refused [glc_id=42 trace=abc123 user=bob]
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Err.Error())
+	b.WriteString(" [glc_id=")
+	b.WriteString(strconv.FormatUint(e.ID, 10))
+	keys := make([]string, 0, len(e.Values))
+	for k := range e.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteByte(' ')
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(e.Values[k])
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// Unwrap returns the wrapped error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+type extractor struct {
+	f func(ctx context.Context) map[string]string
+}
+
+var extract atomic.Pointer[extractor]
+
+// SetExtractor sets the function Wrap calls with the bound context to get the
+// values worth recording with an error, such as a trace ID, user, or operation
+// name. Setting it to nil records only the binding ID.
+func SetExtractor(f func(ctx context.Context) map[string]string) {
+	if f == nil {
+		extract.Store(nil)
+		return
+	}
+	extract.Store(&extractor{f: f})
+}
+
+// Wrap annotates `err` with the binding it is called under. It returns `err`
+// unchanged if it is nil, if no context is bound, or if `err` has already been
+// wrapped under the same binding.
+func Wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+	id, ok := glc.BindingID()
+	if !ok {
+		return err
+	}
+	var prev *Error
+	if errors.As(err, &prev) && prev.ID == id {
+		return err
+	}
+	e := &Error{Err: err, ID: id}
+	if x := extract.Load(); x != nil {
+		if ctx := glc.GetContext(); ctx != nil {
+			e.Values = x.f(ctx)
+		}
+	}
+	return e
+}
@@ -0,0 +1,63 @@
+package glcerrors
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type userKey struct{}
+
+func TestWrap(t *testing.T) {
+	if Wrap(nil) != nil {
+		t.Errorf("Wrap(nil) is not nil")
+	}
+	if err := Wrap(io.EOF); err != io.EOF {
+		t.Errorf("unbound Wrap returned %v, want io.EOF unchanged", err)
+	}
+
+	SetExtractor(func(ctx context.Context) map[string]string {
+		u, _ := ctx.Value(userKey{}).(string)
+		return map[string]string{"user": u, "op": "test"}
+	})
+	defer SetExtractor(nil)
+
+	ctx := context.WithValue(context.Background(), userKey{}, "bob")
+	glc.WithContext(ctx, func() {
+		id, _ := glc.BindingID()
+		err := Wrap(io.EOF)
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("wrapped error is not io.EOF")
+		}
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Fatalf("errors.As found no *Error in %v", err)
+		}
+		if e.ID != id || e.Values["user"] != "bob" || e.Values["op"] != "test" {
+			t.Errorf("got ID %d, values %v; want ID %d, user bob, op test", e.ID, e.Values, id)
+		}
+		if Wrap(err) != err {
+			t.Errorf("wrapping twice under the same binding wrapped again")
+		}
+
+		glc.WithContext(ctx, func() {
+			inner := Wrap(err)
+			if inner == err {
+				t.Errorf("wrapping under a new binding didn't wrap")
+			}
+			if !errors.As(inner, &e) || e.ID == id {
+				t.Errorf("errors.As found ID %d, want the inner binding's", e.ID)
+			}
+		})
+	})
+}
+
+func TestErrorString(t *testing.T) {
+	e := &Error{Err: io.EOF, ID: 42, Values: map[string]string{"user": "bob", "trace": "abc"}}
+	if got, want := e.Error(), "EOF [glc_id=42 trace=abc user=bob]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
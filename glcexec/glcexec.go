@@ -0,0 +1,23 @@
+// Package glcexec starts commands under the context bound by glc.WithContext, so
+// that subprocesses started from deep within a request are killed when the
+// request is cancelled.
+package glcexec
+
+import (
+	"os/exec"
+
+	"github.com/knusbaum/glc"
+)
+
+// Command is exec.CommandContext with the bound context. If no context is bound,
+// it's exec.Command.
+//
+// As with exec.CommandContext, the context is only consulted when the command is
+// started and while it runs, so the command has to be started within the scope
+// of the binding for the binding to have any effect.
+func Command(name string, arg ...string) *exec.Cmd {
+	if ctx := glc.GetContext(); ctx != nil {
+		return exec.CommandContext(ctx, name, arg...)
+	}
+	return exec.Command(name, arg...)
+}
@@ -0,0 +1,38 @@
+package glcexec
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+func TestCommand(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("no sleep command")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	var err error
+	glc.WithContext(ctx, func() {
+		err = Command("sleep", "10").Run()
+	})
+	if err == nil {
+		t.Fatal("expected the command to be killed")
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("command ran for %v after its context expired", d)
+	}
+}
+
+func TestCommandUnbound(t *testing.T) {
+	if _, err := exec.LookPath("true"); err != nil {
+		t.Skip("no true command")
+	}
+	if err := Command("true").Run(); err != nil {
+		t.Fatal(err)
+	}
+}
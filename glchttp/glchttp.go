@@ -0,0 +1,183 @@
+// Package glchttp binds the contexts of HTTP requests with glc, so that code
+// serving a request can get at the request's context with glc.GetContext.
+package glchttp
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+// Handler returns a handler which calls `next` with the request's context bound
+// by `glc.WithContext`.
+//
+// As with any binding, goroutines started by `next` don't see it. Handlers that
+// hand work off to other goroutines have to pass the context along themselves.
+func Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		glc.WithContext(r.Context(), func() {
+			next.ServeHTTP(w, r)
+		})
+	})
+}
+
+// HandlerFunc is Handler for handler functions.
+func HandlerFunc(next func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return Handler(http.HandlerFunc(next)).ServeHTTP
+}
+
+// Transport returns a RoundTripper which sends requests with `base`, after
+// giving requests made without a context the context bound by
+// `glc.WithContext`. A request is taken to have no context if its context is
+// `context.Background()` or `context.TODO()`, which is what `http.NewRequest`
+// and friends give it.
+//
+// This lets deadlines and cancellation reach clients buried in code that never
+// had a context to give them. If `base` is nil, `http.DefaultTransport` is used.
+//
+// An `http.Client` with a Timeout gives each request a deadline before its
+// transport sees it, so the request's context is no longer
+// `context.Background()`, and the bound context never gets substituted. Use
+// Client for those.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return roundTripper{base: base}
+}
+
+// Client returns a copy of `c` whose requests get the context bound by
+// `glc.WithContext` as Transport's do, whether or not `c` has a Timeout.
+//
+// The copy's transport applies `c`'s Timeout itself, once it has substituted
+// the bound context, and the copy has no Timeout of its own. The timeout then
+// applies to each request the client sends, so a request that's redirected
+// gets the timeout again for each redirect it follows.
+func Client(c *http.Client) *http.Client {
+	cc := *c
+	base := c.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	cc.Transport = roundTripper{base: base, timeout: c.Timeout}
+	cc.Timeout = 0
+	return &cc
+}
+
+type roundTripper struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if ctx := r.Context(); ctx == context.Background() || ctx == context.TODO() {
+		if bound := glc.GetContext(); bound != nil {
+			r = r.WithContext(bound)
+		}
+	}
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(r)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The timeout covers reading the body, as a Client's does.
+	resp.Body = cancelBody{resp.Body, cancel}
+	return resp, nil
+}
+
+// cancelBody is a response body that cancels its request's context once it's
+// closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// BaseContext can be used as an `http.Server`'s BaseContext. It makes the
+// context bound by `glc.WithContext` around the call to Serve (or
+// ListenAndServe, and so on) the base of every request's context, so that
+// cancelling it cancels them all. If nothing is bound, it returns
+// `context.Background()`, which is what the server would have used anyway.
+func BaseContext(net.Listener) context.Context {
+	if ctx := glc.GetContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+type connKey struct{}
+
+// ConnContext can be used as an `http.Server`'s ConnContext. It records the
+// connection in the context of every request made on it. See `ConnFrom`.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, connKey{}, c)
+}
+
+// ConnFrom returns the connection recorded in ctx by ConnContext.
+func ConnFrom(ctx context.Context) (net.Conn, bool) {
+	c, ok := ctx.Value(connKey{}).(net.Conn)
+	return c, ok
+}
+
+// RequestInfo is what HandlerWithInfo records about each request.
+type RequestInfo struct {
+	// RemoteAddr is the request's RemoteAddr.
+	RemoteAddr string
+	// ServerName is the name the client asked for: the TLS server name if
+	// there is one, and otherwise the request's Host.
+	ServerName string
+}
+
+type infoKey struct{}
+
+// RequestInfoFrom returns the RequestInfo recorded in ctx by HandlerWithInfo.
+func RequestInfoFrom(ctx context.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(infoKey{}).(RequestInfo)
+	return info, ok
+}
+
+// HandlerWithInfo is Handler, but the context it binds also carries a
+// RequestInfo for the request.
+func HandlerWithInfo(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := RequestInfo{RemoteAddr: r.RemoteAddr, ServerName: r.Host}
+		if r.TLS != nil && r.TLS.ServerName != "" {
+			info.ServerName = r.TLS.ServerName
+		}
+		r = r.WithContext(context.WithValue(r.Context(), infoKey{}, info))
+		glc.WithContext(r.Context(), func() {
+			next.ServeHTTP(w, r)
+		})
+	})
+}
+
+// Configure sets up `srv` to bind a context for every request, for servers that
+// aren't built with a middleware chain. It sets the server's BaseContext and
+// ConnContext to the ones in this package, unless they're already set, and
+// wraps its handler with HandlerWithInfo.
+func Configure(srv *http.Server) {
+	if srv.BaseContext == nil {
+		srv.BaseContext = BaseContext
+	}
+	if srv.ConnContext == nil {
+		srv.ConnContext = ConnContext
+	}
+	h := srv.Handler
+	if h == nil {
+		h = http.DefaultServeMux
+	}
+	srv.Handler = HandlerWithInfo(h)
+}
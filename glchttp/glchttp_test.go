@@ -0,0 +1,162 @@
+package glchttp
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestHandler(t *testing.T) {
+	var got context.Context
+	h := Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = glc.GetContext()
+	}))
+	r := httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), key{}, "request"))
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if got != r.Context() {
+		t.Errorf("got context %v, want the request's", got)
+	}
+	if glc.GetContext() != nil {
+		t.Error("binding outlived the request")
+	}
+}
+
+func TestHandlerFunc(t *testing.T) {
+	var got context.Context
+	h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = glc.GetContext()
+	})
+	r := httptest.NewRequest("GET", "/", nil)
+	h(httptest.NewRecorder(), r)
+	if got != r.Context() {
+		t.Errorf("got context %v, want the request's", got)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestTransport(t *testing.T) {
+	var got context.Context
+	tr := Transport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Context()
+		return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+	}))
+	client := &http.Client{Transport: tr}
+
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	glc.WithContext(bound, func() {
+		// No context of its own, so it picks up the bound one.
+		if _, err := client.Get("http://example.com/"); err != nil {
+			t.Fatal(err)
+		}
+		if got != bound {
+			t.Errorf("got context %v, want the bound one", got)
+		}
+
+		// A context of its own, which is left alone.
+		own := context.WithValue(context.Background(), key{}, "own")
+		r, _ := http.NewRequestWithContext(own, "GET", "http://example.com/", nil)
+		if _, err := client.Do(r); err != nil {
+			t.Fatal(err)
+		}
+		if got != own {
+			t.Errorf("got context %v, want the request's own", got)
+		}
+	})
+
+	// Nothing bound, so nothing changes.
+	if _, err := client.Get("http://example.com/"); err != nil {
+		t.Fatal(err)
+	}
+	if got != context.Background() {
+		t.Errorf("got context %v, want context.Background()", got)
+	}
+}
+
+func TestClient(t *testing.T) {
+	var got context.Context
+	tr := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Context()
+		return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+	})
+
+	// A Timeout wraps the request's context in a deadline before the
+	// transport sees it, which Transport alone would take for a context of
+	// the request's own.
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	client := Client(&http.Client{Transport: tr, Timeout: time.Minute})
+	glc.WithContext(bound, func() {
+		resp, err := client.Get("http://example.com/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Value(key{}) != "bound" {
+			t.Errorf("got context %v, want one from the bound one", got)
+		}
+		if d, ok := got.Deadline(); !ok || time.Until(d) > time.Minute {
+			t.Errorf("got deadline %v, %t, want one within the Timeout", d, ok)
+		}
+		resp.Body.Close()
+		if got.Err() == nil {
+			t.Errorf("request's context wasn't cancelled once its body was closed")
+		}
+	})
+}
+
+func TestConfigure(t *testing.T) {
+	type result struct {
+		base string
+		info RequestInfo
+		conn bool
+	}
+	results := make(chan result, 1)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := glc.GetContext()
+		var res result
+		res.base, _ = ctx.Value(key{}).(string)
+		res.info, _ = RequestInfoFrom(ctx)
+		_, res.conn = ConnFrom(ctx)
+		results <- res
+	})}
+	Configure(srv)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("can't listen: %v", err)
+	}
+	serverCtx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "server"))
+	defer cancel()
+	go glc.WithContext(serverCtx, func() {
+		srv.Serve(l)
+	})
+	defer srv.Close()
+
+	resp, err := http.Get("http://" + l.Addr().String() + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	res := <-results
+	if res.base != "server" {
+		t.Errorf("request context isn't based on the server's bound context")
+	}
+	if !res.conn {
+		t.Errorf("no connection recorded")
+	}
+	if res.info.ServerName != l.Addr().String() || res.info.RemoteAddr == "" {
+		t.Errorf("got request info %+v", res.info)
+	}
+}
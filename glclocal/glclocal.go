@@ -0,0 +1,91 @@
+// Package glclocal is a small interface over goroutine-local contexts, in the
+// shape runtime-provided goroutine-local storage is usually proposed in: a
+// value bound for the duration of a call, read back further down the same
+// goroutine, and optionally handed to goroutines it starts.
+//
+// Code written against this package rather than glc itself can move to such a
+// runtime API, should one appear, by supplying a Storage backed by it to Set at
+// startup. Nothing else has to change.
+package glclocal
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/knusbaum/glc"
+)
+
+// Storage binds contexts to goroutines.
+type Storage interface {
+	// Current returns the context bound to the calling goroutine, if there
+	// is one.
+	Current() (context.Context, bool)
+	// With calls f with ctx bound to the calling goroutine. The binding
+	// ends when f returns or panics, and bindings made within f hide it
+	// until they end.
+	With(ctx context.Context, f func())
+	// Go calls f on a new goroutine, with the context bound to the calling
+	// goroutine, if there is one, bound to the new one.
+	Go(f func())
+}
+
+// Glc returns the Storage implemented by glc.WithContext and glc.GetContext.
+// It's the Storage used until Set is called.
+func Glc() Storage {
+	return glcStorage{}
+}
+
+type glcStorage struct{}
+
+func (glcStorage) Current() (context.Context, bool) {
+	ctx := glc.GetContext()
+	return ctx, ctx != nil
+}
+
+func (glcStorage) With(ctx context.Context, f func()) {
+	glc.WithContext(ctx, f)
+}
+
+func (glcStorage) Go(f func()) {
+	ctx := glc.GetContext()
+	if ctx == nil {
+		go f()
+		return
+	}
+	go glc.WithContext(ctx, f)
+}
+
+type holder struct{ s Storage }
+
+var storage atomic.Pointer[holder]
+
+// Set makes s the Storage used by Current, With and Go. It's meant to be called
+// once, at startup, before anything is bound: contexts bound by one Storage
+// aren't visible to another.
+func Set(s Storage) {
+	storage.Store(&holder{s})
+}
+
+// Get returns the Storage used by Current, With and Go.
+func Get() Storage {
+	if h := storage.Load(); h != nil {
+		return h.s
+	}
+	return glcStorage{}
+}
+
+// Current returns the context bound to the calling goroutine, if there is one.
+func Current() (context.Context, bool) {
+	return Get().Current()
+}
+
+// With calls f with ctx bound to the calling goroutine.
+func With(ctx context.Context, f func()) {
+	Get().With(ctx, f)
+}
+
+// Go calls f on a new goroutine which inherits the calling goroutine's
+// context.
+func Go(f func()) {
+	Get().Go(f)
+}
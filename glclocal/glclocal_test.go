@@ -0,0 +1,75 @@
+package glclocal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestGlc(t *testing.T) {
+	if ctx, ok := Current(); ok {
+		t.Errorf("unbound: got %v", ctx)
+	}
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	With(bound, func() {
+		if ctx, ok := Current(); !ok || ctx != bound {
+			t.Errorf("got %v, %t, want %v", ctx, ok, bound)
+		}
+		if ctx := glc.GetContext(); ctx != bound {
+			t.Errorf("glc.GetContext() = %v, want %v", ctx, bound)
+		}
+		got := make(chan context.Context)
+		Go(func() {
+			ctx, _ := Current()
+			got <- ctx
+		})
+		if ctx := <-got; ctx != bound {
+			t.Errorf("new goroutine got %v, want %v", ctx, bound)
+		}
+	})
+}
+
+// stackStorage is a Storage that isn't goroutine-local at all, which is fine
+// for a test that stays on one goroutine.
+type stackStorage struct {
+	stack []context.Context
+}
+
+func (s *stackStorage) Current() (context.Context, bool) {
+	if len(s.stack) == 0 {
+		return nil, false
+	}
+	return s.stack[len(s.stack)-1], true
+}
+
+func (s *stackStorage) With(ctx context.Context, f func()) {
+	s.stack = append(s.stack, ctx)
+	defer func() { s.stack = s.stack[:len(s.stack)-1] }()
+	f()
+}
+
+func (s *stackStorage) Go(f func()) {
+	panic("not implemented")
+}
+
+func TestSet(t *testing.T) {
+	s := &stackStorage{}
+	Set(s)
+	defer Set(Glc())
+
+	bound := context.WithValue(context.Background(), key{}, "bound")
+	With(bound, func() {
+		if ctx, ok := Current(); !ok || ctx != bound {
+			t.Errorf("got %v, %t, want %v", ctx, ok, bound)
+		}
+		if ctx := glc.GetContext(); ctx != nil {
+			t.Errorf("glc saw a context bound by another Storage: %v", ctx)
+		}
+	})
+	if len(s.stack) != 0 {
+		t.Errorf("binding outlived With: %v", s.stack)
+	}
+}
@@ -0,0 +1,87 @@
+// Package glcnet dials connections and resolves names under the context bound by
+// glc.WithContext, so that networking code with no context to pass still
+// respects the deadlines and cancellation of the request it's running for.
+package glcnet
+
+import (
+	"context"
+	"net"
+
+	"github.com/knusbaum/glc"
+)
+
+// bound returns the context bound by glc.WithContext, or context.Background()
+// if there isn't one.
+func bound() context.Context {
+	if ctx := glc.GetContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// Dialer is a net.Dialer whose Dial method uses the bound context.
+type Dialer struct {
+	net.Dialer
+}
+
+// Dial is DialContext with the bound context.
+func (d *Dialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(bound(), network, address)
+}
+
+// Dial is net.Dial, but uses the bound context.
+func Dial(network, address string) (net.Conn, error) {
+	var d Dialer
+	return d.Dial(network, address)
+}
+
+// Resolver is a net.Resolver whose methods take no context, and use the bound
+// one instead. As usual, a nil *net.Resolver behaves like net.DefaultResolver.
+type Resolver struct {
+	*net.Resolver
+}
+
+// DefaultResolver is a Resolver using net.DefaultResolver.
+var DefaultResolver = &Resolver{}
+
+func (r *Resolver) LookupHost(host string) ([]string, error) {
+	return r.Resolver.LookupHost(bound(), host)
+}
+
+func (r *Resolver) LookupIP(network, host string) ([]net.IP, error) {
+	return r.Resolver.LookupIP(bound(), network, host)
+}
+
+func (r *Resolver) LookupAddr(addr string) ([]string, error) {
+	return r.Resolver.LookupAddr(bound(), addr)
+}
+
+func (r *Resolver) LookupPort(network, service string) (int, error) {
+	return r.Resolver.LookupPort(bound(), network, service)
+}
+
+func (r *Resolver) LookupCNAME(host string) (string, error) {
+	return r.Resolver.LookupCNAME(bound(), host)
+}
+
+func (r *Resolver) LookupMX(name string) ([]*net.MX, error) {
+	return r.Resolver.LookupMX(bound(), name)
+}
+
+func (r *Resolver) LookupTXT(name string) ([]string, error) {
+	return r.Resolver.LookupTXT(bound(), name)
+}
+
+func (r *Resolver) LookupSRV(service, proto, name string) (string, []*net.SRV, error) {
+	return r.Resolver.LookupSRV(bound(), service, proto, name)
+}
+
+// LookupHost is net.LookupHost, but uses the bound context.
+func LookupHost(host string) ([]string, error) {
+	return DefaultResolver.LookupHost(host)
+}
+
+// LookupIP is net.LookupIP, but uses the bound context.
+func LookupIP(host string) ([]net.IP, error) {
+	return DefaultResolver.LookupIP("ip", host)
+}
@@ -0,0 +1,58 @@
+package glcnet
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestDial(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("can't listen: %v", err)
+	}
+	defer l.Close()
+
+	c, err := Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("unbound Dial: %v", err)
+	}
+	c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	glc.WithContext(ctx, func() {
+		if c, err := Dial("tcp", l.Addr().String()); err == nil {
+			c.Close()
+			t.Error("Dial succeeded with its bound context cancelled")
+		}
+	})
+}
+
+func TestResolver(t *testing.T) {
+	// The resolver looks up A and AAAA records at the same time.
+	var mu sync.Mutex
+	var got any
+	errDial := errors.New("no dialing in tests")
+	r := &Resolver{&net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			mu.Lock()
+			got = ctx.Value(key{})
+			mu.Unlock()
+			return nil, errDial
+		},
+	}}
+	glc.WithContext(context.WithValue(context.Background(), key{}, "bound"), func() {
+		r.LookupHost("glcnet.invalid")
+	})
+	if got != "bound" {
+		t.Errorf("resolver dialed with context value %v, want bound", got)
+	}
+}
@@ -0,0 +1,176 @@
+// Package glcrpc serves net/rpc calls with a binding made by glc.WithContext
+// around each method invocation. net/rpc methods take no context, so without
+// it they have no way to learn who called them or when to give up.
+package glcrpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+
+	"github.com/knusbaum/glc"
+)
+
+// CallInfo describes the call a method is running for.
+type CallInfo struct {
+	// ServiceMethod is the name of the method, as "Service.Method".
+	ServiceMethod string
+	// Seq is the sequence number the client chose for the call.
+	Seq uint64
+	// RemoteAddr is the address of the client, or nil if the connection
+	// doesn't say.
+	RemoteAddr net.Addr
+}
+
+type callInfoKey struct{}
+
+// CallInfoFrom returns the CallInfo stored in `ctx` by a Server, if any.
+func CallInfoFrom(ctx context.Context) (CallInfo, bool) {
+	ci, ok := ctx.Value(callInfoKey{}).(CallInfo)
+	return ci, ok
+}
+
+// Server is an rpc.Server whose Serve methods bind a context around every call
+// they dispatch. The context carries a CallInfo, and is cancelled when the
+// call's method returns or when the Server is shut down.
+//
+// Calls served by the embedded rpc.Server's own ServeConn, ServeCodec and
+// ServeRequest methods are not bound. Use the ones defined on Server.
+type Server struct {
+	*rpc.Server
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewServer returns a Server using a new rpc.Server.
+func NewServer() *Server {
+	return Wrap(rpc.NewServer())
+}
+
+// Wrap returns a Server using srv.
+func Wrap(srv *rpc.Server) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Server{Server: srv, ctx: ctx, cancel: cancel}
+}
+
+// Shutdown cancels the contexts of all calls in progress. Calls dispatched
+// afterwards start out with their contexts cancelled. Shutdown doesn't close
+// any connections or listeners.
+func (s *Server) Shutdown() {
+	s.cancel()
+}
+
+// Accept accepts connections on the listener and serves requests for each
+// incoming connection, as rpc.Server.Accept does.
+func (s *Server) Accept(lis net.Listener) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		go s.ServeConn(conn)
+	}
+}
+
+// ServeConn serves a single connection using the gob codec, as
+// rpc.Server.ServeConn does. It blocks until the client hangs up.
+func (s *Server) ServeConn(conn io.ReadWriteCloser) {
+	s.serve(newGobServerCodec(conn), remoteAddr(conn))
+}
+
+// ServeJSONConn serves a single connection using the JSON-RPC codec, as
+// jsonrpc.ServeConn does. It blocks until the client hangs up.
+func (s *Server) ServeJSONConn(conn io.ReadWriteCloser) {
+	s.serve(jsonrpc.NewServerCodec(conn), remoteAddr(conn))
+}
+
+// ServeCodec is like ServeConn, but uses the given codec. The CallInfo of calls
+// served this way has no RemoteAddr.
+func (s *Server) ServeCodec(codec rpc.ServerCodec) {
+	s.serve(codec, nil)
+}
+
+func remoteAddr(conn io.ReadWriteCloser) net.Addr {
+	if c, ok := conn.(interface{ RemoteAddr() net.Addr }); ok {
+		return c.RemoteAddr()
+	}
+	return nil
+}
+
+// serve reads each request header itself, then hands the rest of the request
+// to rpc.Server.ServeRequest in a goroutine bound to the call's context. It
+// waits for the request body to be read before reading the next header, since
+// the codec reads them from the same stream, but lets calls run concurrently
+// as rpc.Server.ServeCodec does.
+func (s *Server) serve(codec rpc.ServerCodec, addr net.Addr) {
+	var wg sync.WaitGroup
+	var sending sync.Mutex
+	for {
+		var req rpc.Request
+		if err := codec.ReadRequestHeader(&req); err != nil {
+			break
+		}
+		ctx, cancel := context.WithCancel(context.WithValue(s.ctx, callInfoKey{}, CallInfo{
+			ServiceMethod: req.ServiceMethod,
+			Seq:           req.Seq,
+			RemoteAddr:    addr,
+		}))
+		rc := &requestCodec{ServerCodec: codec, req: req, sending: &sending, read: make(chan struct{})}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer cancel()
+			glc.WithContext(ctx, func() {
+				// Errors are reported to the client by ServeRequest.
+				s.Server.ServeRequest(rc)
+			})
+			// ServeRequest reads the body before doing anything else, but
+			// don't leave serve waiting if it ever doesn't.
+			rc.bodyRead()
+		}()
+		<-rc.read
+	}
+	// As rpc.Server.ServeCodec does, let calls in progress finish before
+	// closing the codec.
+	wg.Wait()
+	codec.Close()
+}
+
+// requestCodec is the codec for a single request whose header has already been
+// read. It signals on read once the body has been read, and serializes writes
+// to the underlying codec with the other requests on the same connection.
+type requestCodec struct {
+	rpc.ServerCodec
+	req     rpc.Request
+	sending *sync.Mutex
+	read    chan struct{}
+	once    sync.Once
+}
+
+func (c *requestCodec) bodyRead() {
+	c.once.Do(func() { close(c.read) })
+}
+
+func (c *requestCodec) ReadRequestHeader(r *rpc.Request) error {
+	r.ServiceMethod, r.Seq = c.req.ServiceMethod, c.req.Seq
+	return nil
+}
+
+func (c *requestCodec) ReadRequestBody(body any) error {
+	defer c.bodyRead()
+	return c.ServerCodec.ReadRequestBody(body)
+}
+
+func (c *requestCodec) WriteResponse(r *rpc.Response, body any) error {
+	c.sending.Lock()
+	defer c.sending.Unlock()
+	return c.ServerCodec.WriteResponse(r, body)
+}
+
+func (c *requestCodec) Close() error {
+	// The connection is closed by serve, once all of its calls are done.
+	return nil
+}
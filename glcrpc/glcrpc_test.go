@@ -0,0 +1,94 @@
+package glcrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type Svc struct {
+	blocked chan struct{}
+}
+
+type Reply struct {
+	ServiceMethod string
+	Seq           uint64
+}
+
+// Info reports the CallInfo found through glc.GetContext.
+func (s *Svc) Info(arg int, reply *Reply) error {
+	ctx := glc.GetContext()
+	if ctx == nil {
+		return errors.New("no bound context")
+	}
+	ci, ok := CallInfoFrom(ctx)
+	if !ok {
+		return errors.New("no CallInfo")
+	}
+	reply.ServiceMethod, reply.Seq = ci.ServiceMethod, ci.Seq
+	return nil
+}
+
+// Block waits for the bound context to be cancelled.
+func (s *Svc) Block(arg int, reply *Reply) error {
+	ctx := glc.GetContext()
+	close(s.blocked)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func serve(t *testing.T, json bool) (*Server, *Svc, *rpc.Client) {
+	s := NewServer()
+	svc := &Svc{blocked: make(chan struct{})}
+	if err := s.Register(svc); err != nil {
+		t.Fatal(err)
+	}
+	sc, cc := net.Pipe()
+	var client *rpc.Client
+	if json {
+		go s.ServeJSONConn(sc)
+		client = jsonrpc.NewClient(cc)
+	} else {
+		go s.ServeConn(sc)
+		client = rpc.NewClient(cc)
+	}
+	t.Cleanup(func() { client.Close() })
+	return s, svc, client
+}
+
+func TestServer(t *testing.T) {
+	for _, json := range []bool{false, true} {
+		s, svc, client := serve(t, json)
+
+		for i := 0; i < 3; i++ {
+			var reply Reply
+			if err := client.Call("Svc.Info", i, &reply); err != nil {
+				t.Fatalf("json %t: %v", json, err)
+			}
+			if reply.ServiceMethod != "Svc.Info" {
+				t.Errorf("json %t: got %+v", json, reply)
+			}
+		}
+
+		if err := client.Call("Svc.Missing", 0, new(Reply)); err == nil {
+			t.Errorf("json %t: calling a missing method succeeded", json)
+		}
+
+		call := client.Go("Svc.Block", 0, new(Reply), nil)
+		<-svc.blocked
+		// Calls keep being served while another is in progress.
+		if err := client.Call("Svc.Info", 0, new(Reply)); err != nil {
+			t.Errorf("json %t: %v", json, err)
+		}
+		s.Shutdown()
+		<-call.Done
+		if call.Error == nil || call.Error.Error() != context.Canceled.Error() {
+			t.Errorf("json %t: blocked call returned %v, want %v", json, call.Error, context.Canceled)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Package glcsql wraps database/sql so that the methods which take no context use
+// the context bound by glc.WithContext instead of context.Background(). Code that
+// calls Query or Exec gets cancellation and deadlines from the surrounding
+// request without having to be changed to call QueryContext or ExecContext.
+//
+// The context-taking methods use the context they're given. Those returning a
+// statement, transaction or connection return this package's wrappers, so that
+// its methods without a context use the bound one too.
+package glcsql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/knusbaum/glc"
+)
+
+// bound returns the context bound by glc.WithContext, or context.Background()
+// if there isn't one.
+func bound() context.Context {
+	if ctx := glc.GetContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// DB is a sql.DB whose methods without a context use the bound one.
+type DB struct {
+	*sql.DB
+}
+
+// Open is sql.Open, returning a DB.
+func Open(driverName, dataSourceName string) (*DB, error) {
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{db}, nil
+}
+
+// Wrap returns a DB using db.
+func Wrap(db *sql.DB) *DB {
+	return &DB{db}
+}
+
+func (db *DB) Ping() error {
+	return db.PingContext(bound())
+}
+
+func (db *DB) Exec(query string, args ...any) (sql.Result, error) {
+	return db.ExecContext(bound(), query, args...)
+}
+
+func (db *DB) Query(query string, args ...any) (*sql.Rows, error) {
+	return db.QueryContext(bound(), query, args...)
+}
+
+func (db *DB) QueryRow(query string, args ...any) *sql.Row {
+	return db.QueryRowContext(bound(), query, args...)
+}
+
+// Prepare prepares a statement with the bound context. The statement's own
+// methods without a context use whatever context is bound when they're called.
+func (db *DB) Prepare(query string) (*Stmt, error) {
+	return db.PrepareContext(bound(), query)
+}
+
+func (db *DB) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	s, err := db.DB.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{s}, nil
+}
+
+// Begin starts a transaction with the bound context. As with sql.DB's BeginTx,
+// the transaction is rolled back if the context is cancelled before it
+// finishes.
+func (db *DB) Begin() (*Tx, error) {
+	return db.BeginTx(bound(), nil)
+}
+
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{tx}, nil
+}
+
+// Conn returns a single connection from the pool. sql.Conn only has methods
+// taking a context, but the statements and transactions it makes are wrapped.
+func (db *DB) Conn(ctx context.Context) (*Conn, error) {
+	c, err := db.DB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{c}, nil
+}
+
+// Conn is a sql.Conn whose statements and transactions use the bound context in
+// their methods without a context.
+type Conn struct {
+	*sql.Conn
+}
+
+func (c *Conn) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	s, err := c.Conn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{s}, nil
+}
+
+func (c *Conn) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := c.Conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{tx}, nil
+}
+
+// Tx is a sql.Tx whose methods without a context use the bound one.
+type Tx struct {
+	*sql.Tx
+}
+
+func (tx *Tx) Exec(query string, args ...any) (sql.Result, error) {
+	return tx.ExecContext(bound(), query, args...)
+}
+
+func (tx *Tx) Query(query string, args ...any) (*sql.Rows, error) {
+	return tx.QueryContext(bound(), query, args...)
+}
+
+func (tx *Tx) QueryRow(query string, args ...any) *sql.Row {
+	return tx.QueryRowContext(bound(), query, args...)
+}
+
+func (tx *Tx) Prepare(query string) (*Stmt, error) {
+	return tx.PrepareContext(bound(), query)
+}
+
+func (tx *Tx) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	s, err := tx.Tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{s}, nil
+}
+
+// Stmt returns a transaction-specific statement from s, prepared with the bound
+// context.
+func (tx *Tx) Stmt(s *Stmt) *Stmt {
+	return tx.StmtContext(bound(), s)
+}
+
+func (tx *Tx) StmtContext(ctx context.Context, s *Stmt) *Stmt {
+	return &Stmt{tx.Tx.StmtContext(ctx, s.Stmt)}
+}
+
+// Stmt is a sql.Stmt whose methods without a context use the bound one.
+type Stmt struct {
+	*sql.Stmt
+}
+
+func (s *Stmt) Exec(args ...any) (sql.Result, error) {
+	return s.ExecContext(bound(), args...)
+}
+
+func (s *Stmt) Query(args ...any) (*sql.Rows, error) {
+	return s.QueryContext(bound(), args...)
+}
+
+func (s *Stmt) QueryRow(args ...any) *sql.Row {
+	return s.QueryRowContext(bound(), args...)
+}
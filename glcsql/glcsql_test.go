@@ -0,0 +1,171 @@
+package glcsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+// ctxDriver is a database driver which records the value under key in the
+// context of each call made on it.
+type ctxDriver struct {
+	seen []any
+}
+
+func (d *ctxDriver) Open(name string) (driver.Conn, error) {
+	return &ctxConn{d}, nil
+}
+
+func (d *ctxDriver) record(ctx context.Context) {
+	d.seen = append(d.seen, ctx.Value(key{}))
+}
+
+type ctxConn struct {
+	d *ctxDriver
+}
+
+func (c *ctxConn) Prepare(query string) (driver.Stmt, error) {
+	panic("called Prepare instead of PrepareContext")
+}
+
+func (c *ctxConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	c.d.record(ctx)
+	return &ctxStmt{c.d}, nil
+}
+
+func (c *ctxConn) Close() error { return nil }
+
+func (c *ctxConn) Begin() (driver.Tx, error) {
+	panic("called Begin instead of BeginTx")
+}
+
+func (c *ctxConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.d.record(ctx)
+	return ctxTx{}, nil
+}
+
+func (c *ctxConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.d.record(ctx)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *ctxConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.d.record(ctx)
+	return emptyRows{}, nil
+}
+
+func (c *ctxConn) Ping(ctx context.Context) error {
+	c.d.record(ctx)
+	return nil
+}
+
+type ctxStmt struct {
+	d *ctxDriver
+}
+
+func (s *ctxStmt) Close() error  { return nil }
+func (s *ctxStmt) NumInput() int { return -1 }
+
+func (s *ctxStmt) Exec(args []driver.Value) (driver.Result, error) {
+	panic("called Exec instead of ExecContext")
+}
+
+func (s *ctxStmt) Query(args []driver.Value) (driver.Rows, error) {
+	panic("called Query instead of QueryContext")
+}
+
+func (s *ctxStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	s.d.record(ctx)
+	return driver.RowsAffected(0), nil
+}
+
+func (s *ctxStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	s.d.record(ctx)
+	return emptyRows{}, nil
+}
+
+type ctxTx struct{}
+
+func (ctxTx) Commit() error   { return nil }
+func (ctxTx) Rollback() error { return nil }
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string              { return nil }
+func (emptyRows) Close() error                   { return nil }
+func (emptyRows) Next(dest []driver.Value) error { return io.EOF }
+
+func TestDB(t *testing.T) {
+	d := &ctxDriver{}
+	sql.Register("glcsql-test", d)
+	db, err := Open("glcsql-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	glc.WithContext(context.WithValue(context.Background(), key{}, "bound"), func() {
+		if err := db.Ping(); err != nil {
+			t.Fatal(err)
+		}
+		db.Exec("exec")
+		rows, _ := db.Query("query")
+		rows.Close()
+		db.QueryRow("queryrow").Scan()
+
+		s, _ := db.Prepare("prepare")
+		s.Exec()
+		rows, _ = s.Query()
+		rows.Close()
+		s.QueryRow().Scan()
+		s.Close()
+
+		tx, _ := db.Begin()
+		tx.Exec("exec")
+		rows, _ = tx.Query("query")
+		rows.Close()
+		tx.QueryRow("queryrow").Scan()
+		s, _ = tx.Prepare("prepare")
+		s.Exec()
+		tx.Commit()
+
+		// A statement prepared on the DB, and moved into a transaction.
+		s, _ = db.Prepare("prepare")
+		tx, _ = db.Begin()
+		ts := tx.Stmt(s)
+		ts.Exec()
+		tx.StmtContext(glc.GetContext(), s).Exec()
+		tx.Commit()
+		s.Close()
+
+		// Statements and transactions made with a context of their own,
+		// but used without one.
+		c, err := db.Conn(glc.GetContext())
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, _ = c.PrepareContext(glc.GetContext(), "prepare")
+		s.Exec()
+		s.Close()
+		tx, _ = c.BeginTx(glc.GetContext(), nil)
+		tx.Exec("exec")
+		tx.Commit()
+		c.Close()
+	})
+
+	if len(d.seen) != 22 {
+		t.Errorf("expected 22 calls to the driver, got %d", len(d.seen))
+	}
+	for i, v := range d.seen {
+		if v != "bound" {
+			t.Errorf("call %d to the driver got context value %v, want bound", i, v)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Package glctest helps test code that uses glc.
+package glctest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+// Run executes f with a context bound by glc.WithContext for the duration of
+// the test. The context has the test's deadline, if it has one, and is
+// cancelled when the test finishes.
+//
+// When the test finishes, Run fails it if there are more live bindings than
+// there were when Run was called, which means f, or a goroutine it started,
+// left a glc.WithContext scope running. This check can't tell one test's
+// bindings from another's, so it's only reliable for tests that don't run in
+// parallel with other tests that make bindings.
+func Run(t testing.TB, f func()) {
+	t.Helper()
+	var deadline time.Time
+	if d, ok := t.(interface{ Deadline() (time.Time, bool) }); ok {
+		deadline, _ = d.Deadline()
+	}
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if deadline.IsZero() {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithDeadline(context.Background(), deadline)
+	}
+	before := glc.ActiveBindings()
+	t.Cleanup(func() {
+		cancel()
+		if live := glc.ActiveBindings() - before; live > 0 {
+			t.Errorf("glctest: %d binding(s) made during the test are still live", live)
+		}
+	})
+	glc.WithContext(ctx, func() {
+		if glc.GetContext() != ctx {
+			t.Fatal("glctest: the context bound by Run isn't visible to GetContext")
+		}
+		f()
+	})
+}
+
+// Context returns the context bound by glc.WithContext, and fails the test if
+// there isn't one.
+func Context(t testing.TB) context.Context {
+	t.Helper()
+	ctx := glc.GetContext()
+	if ctx == nil {
+		t.Fatal("glctest: no context is bound")
+	}
+	return ctx
+}
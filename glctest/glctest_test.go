@@ -0,0 +1,68 @@
+package glctest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/knusbaum/glc"
+)
+
+func TestRun(t *testing.T) {
+	var ctx context.Context
+	Run(t, func() {
+		ctx = Context(t)
+		if deadline, ok := t.Deadline(); ok {
+			if got, _ := ctx.Deadline(); !got.Equal(deadline) {
+				t.Errorf("context deadline %v, want the test's %v", got, deadline)
+			}
+		}
+	})
+	if ctx.Err() != nil {
+		t.Error("context was cancelled before the test finished")
+	}
+}
+
+func TestRunCancels(t *testing.T) {
+	var ctx context.Context
+	t.Run("sub", func(t *testing.T) {
+		Run(t, func() { ctx = Context(t) })
+	})
+	if ctx.Err() == nil {
+		t.Error("context wasn't cancelled when the test finished")
+	}
+}
+
+// recorder is a testing.TB which records failures instead of failing.
+type recorder struct {
+	testing.TB
+	errors   int
+	cleanups []func()
+}
+
+func (r *recorder) Helper()                                   {}
+func (r *recorder) Errorf(format string, args ...interface{}) { r.errors++ }
+func (r *recorder) Cleanup(f func())                          { r.cleanups = append(r.cleanups, f) }
+
+func TestRunLeak(t *testing.T) {
+	r := &recorder{TB: t}
+	started, done := make(chan struct{}), make(chan struct{})
+	Run(r, func() {
+		go glc.WithContext(context.Background(), func() {
+			close(started)
+			<-done
+		})
+		<-started
+	})
+	for _, f := range r.cleanups {
+		f()
+	}
+	close(done)
+	if r.errors != 1 {
+		t.Errorf("expected the leaked binding to fail the test")
+	}
+	// Let the leaked binding go before the next test looks.
+	for glc.ActiveBindings() > 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
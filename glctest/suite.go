@@ -0,0 +1,209 @@
+package glctest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+// Decoder is anything that binds contexts to the stack and finds them again,
+// as glc.WithContext and glc.GetContext do.
+type Decoder interface {
+	WithContext(ctx context.Context, f func())
+	GetContext() context.Context
+}
+
+// Glc is the Decoder implemented by the glc package itself.
+var Glc Decoder = glcDecoder{}
+
+type glcDecoder struct{}
+
+func (glcDecoder) WithContext(ctx context.Context, f func()) { glc.WithContext(ctx, f) }
+func (glcDecoder) GetContext() context.Context               { return glc.GetContext() }
+
+// Store is a table of contexts by binding ID, like the one glc keeps behind
+// GetContext. IDs are never reused, and a store only ever holds the IDs of
+// live bindings.
+type Store interface {
+	Store(id uint64, ctx context.Context)
+	Load(id uint64) (context.Context, bool)
+	Delete(id uint64)
+}
+
+type suiteKey struct{}
+
+func valued(v any) context.Context {
+	return context.WithValue(context.Background(), suiteKey{}, v)
+}
+
+// DecoderSuite runs the tests every Decoder has to pass: nesting, unwinding
+// by panic, deep stacks, and many goroutines at once.
+func DecoderSuite(t *testing.T, d Decoder) {
+	check := func(t *testing.T, want any) {
+		t.Helper()
+		ctx := d.GetContext()
+		if want == nil {
+			if ctx != nil {
+				t.Errorf("got context %v, want none", ctx)
+			}
+			return
+		}
+		if ctx == nil {
+			t.Errorf("got no context, want one with %v", want)
+		} else if got := ctx.Value(suiteKey{}); got != want {
+			t.Errorf("got context with %v, want %v", got, want)
+		}
+	}
+
+	t.Run("Unbound", func(t *testing.T) {
+		check(t, nil)
+	})
+
+	t.Run("Nesting", func(t *testing.T) {
+		d.WithContext(valued(1), func() {
+			check(t, 1)
+			d.WithContext(valued(2), func() {
+				check(t, 2)
+				d.WithContext(valued(3), func() { check(t, 3) })
+				check(t, 2)
+			})
+			check(t, 1)
+		})
+		check(t, nil)
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		d.WithContext(valued("outer"), func() {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("no panic")
+					}
+					check(t, "outer")
+				}()
+				d.WithContext(valued("inner"), func() {
+					deep(100, func() { panic("boom") })
+				})
+			}()
+			check(t, "outer")
+		})
+		check(t, nil)
+	})
+
+	t.Run("Deep", func(t *testing.T) {
+		for _, depth := range []int{0, 10, 100, 1000, 10000} {
+			d.WithContext(valued(depth), func() {
+				deep(depth, func() { check(t, depth) })
+			})
+		}
+	})
+
+	t.Run("Goroutines", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for g := 0; g < 50; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					want := fmt.Sprint(g, "/", i)
+					d.WithContext(valued(want), func() {
+						deep(i, func() { check(t, want) })
+					})
+				}
+			}(g)
+		}
+		wg.Wait()
+	})
+
+	t.Run("NewGoroutine", func(t *testing.T) {
+		d.WithContext(valued(1), func() {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				check(t, nil)
+			}()
+			<-done
+		})
+	})
+}
+
+//go:noinline
+func deep(n int, f func()) {
+	if n > 0 {
+		deep(n-1, f)
+		return
+	}
+	f()
+}
+
+// StoreSuite runs the tests every Store has to pass. newStore must return a
+// new, empty Store each time it's called.
+func StoreSuite(t *testing.T, newStore func() Store) {
+	t.Run("Basic", func(t *testing.T) {
+		s := newStore()
+		if _, ok := s.Load(1); ok {
+			t.Errorf("empty store has ID 1")
+		}
+		s.Delete(1)
+		ctx := valued(1)
+		s.Store(1, ctx)
+		if got, ok := s.Load(1); !ok || got != ctx {
+			t.Errorf("Load(1) = %v, %t; want %v, true", got, ok, ctx)
+		}
+		s.Delete(1)
+		if _, ok := s.Load(1); ok {
+			t.Errorf("deleted ID 1 is still there")
+		}
+	})
+
+	t.Run("Many", func(t *testing.T) {
+		// More than glc's store keeps in its fixed-size table, and IDs far
+		// apart, so that any second tier of a store gets used too.
+		s := newStore()
+		const n = 20000
+		id := func(i int) uint64 { return uint64(i)*4099 + 1 }
+		for i := 0; i < n; i++ {
+			s.Store(id(i), valued(i))
+		}
+		for i := 0; i < n; i++ {
+			if ctx, ok := s.Load(id(i)); !ok || ctx.Value(suiteKey{}) != i {
+				t.Fatalf("Load(%d) = %v, %t", id(i), ctx, ok)
+			}
+			if i%2 == 0 {
+				s.Delete(id(i))
+			}
+		}
+		for i := 0; i < n; i++ {
+			if _, ok := s.Load(id(i)); ok != (i%2 == 1) {
+				t.Fatalf("Load(%d) found %t after deleting the even ones", id(i), ok)
+			}
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		s := newStore()
+		var wg sync.WaitGroup
+		for g := 0; g < 16; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 2000; i++ {
+					id := uint64(g)<<32 | uint64(i)
+					ctx := valued(id)
+					s.Store(id, ctx)
+					if got, ok := s.Load(id); !ok || got != ctx {
+						t.Errorf("Load(%d) = %v, %t", id, got, ok)
+					}
+					s.Delete(id)
+					if _, ok := s.Load(id); ok {
+						t.Errorf("deleted ID %d is still there", id)
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+	})
+}
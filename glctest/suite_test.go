@@ -0,0 +1,39 @@
+package glctest
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestDecoderSuite(t *testing.T) {
+	DecoderSuite(t, Glc)
+}
+
+type mapStore struct {
+	mu sync.Mutex
+	m  map[uint64]context.Context
+}
+
+func (s *mapStore) Store(id uint64, ctx context.Context) {
+	s.mu.Lock()
+	s.m[id] = ctx
+	s.mu.Unlock()
+}
+
+func (s *mapStore) Load(id uint64) (context.Context, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ctx, ok := s.m[id]
+	return ctx, ok
+}
+
+func (s *mapStore) Delete(id uint64) {
+	s.mu.Lock()
+	delete(s.m, id)
+	s.mu.Unlock()
+}
+
+func TestStoreSuite(t *testing.T) {
+	StoreSuite(t, func() Store { return &mapStore{m: make(map[uint64]context.Context)} })
+}
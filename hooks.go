@@ -0,0 +1,91 @@
+package glc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Hooks are functions called as `WithContext` scopes begin and end, and when
+// `AssertNoBinding` fails. Any of them may be nil. They are called on the
+// goroutine that called WithContext or AssertNoBinding.
+type Hooks struct {
+	// OnBind is called with the ID of a new binding and the context bound,
+	// just before `f` is called.
+	OnBind func(id uint64, ctx context.Context)
+	// OnUnbind is called with the ID of a binding and how long it was live,
+	// once `f` has returned or panicked.
+	OnUnbind func(id uint64, dur time.Duration)
+	// OnViolation is called with the ID and context of the binding
+	// `AssertNoBinding` found, when it finds one.
+	OnViolation func(id uint64, ctx context.Context)
+}
+
+// hookSets is the registered Hooks. It's replaced, never modified, so that
+// WithContext can use it without a lock. It's nil while there are none.
+var hookSets atomic.Pointer[[]*Hooks]
+
+// hooksMu serializes changes to hookSets.
+var hooksMu sync.Mutex
+
+// RegisterHooks adds `h` to the hooks called by every `WithContext`. Hooks
+// registered more than once are called more than once. Each binding uses the
+// hooks registered when it was made, so OnBind and OnUnbind always come in
+// pairs: hooks registered while a binding is live see nothing of it, and hooks
+// unregistered while it's live still see it end.
+//
+// RegisterHooks returns a function which unregisters `h`.
+func RegisterHooks(h Hooks) (unregister func()) {
+	p := &h
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	var hs []*Hooks
+	if old := hookSets.Load(); old != nil {
+		hs = append(hs, *old...)
+	}
+	hs = append(hs, p)
+	hookSets.Store(&hs)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			hooksMu.Lock()
+			defer hooksMu.Unlock()
+			var hs []*Hooks
+			for _, h := range *hookSets.Load() {
+				if h != p {
+					hs = append(hs, h)
+				}
+			}
+			if len(hs) == 0 {
+				hookSets.Store(nil)
+				return
+			}
+			hookSets.Store(&hs)
+		})
+	}
+}
+
+// bindHooks calls the OnBind hooks for a binding, and returns a function
+// which calls the OnUnbind hooks, or nil if there are no hooks.
+func bindHooks(id uint64, ctx context.Context) func() {
+	hs := hookSets.Load()
+	if hs == nil {
+		return nil
+	}
+	start := time.Now()
+	for _, h := range *hs {
+		if h.OnBind != nil {
+			h.OnBind(id, ctx)
+		}
+	}
+	return func() {
+		dur := time.Since(start)
+		for _, h := range *hs {
+			if h.OnUnbind != nil {
+				h.OnUnbind(id, dur)
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package glc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestHooks(t *testing.T) {
+	type event struct {
+		bind bool
+		id   uint64
+	}
+	var events []event
+	unregister := RegisterHooks(Hooks{
+		OnBind: func(id uint64, ctx context.Context) {
+			if ctx == nil {
+				t.Errorf("OnBind got a nil context")
+			}
+			events = append(events, event{true, id})
+		},
+		OnUnbind: func(id uint64, dur time.Duration) {
+			if dur < time.Millisecond {
+				t.Errorf("OnUnbind got duration %v, want at least 1ms", dur)
+			}
+			events = append(events, event{false, id})
+		},
+	})
+	unregisterEmpty := RegisterHooks(Hooks{})
+
+	var outer, inner uint64
+	WithContext(context.Background(), func() {
+		outer, _ = BindingID()
+		WithContext(context.Background(), func() {
+			inner, _ = BindingID()
+			time.Sleep(time.Millisecond)
+		})
+	})
+	unregister()
+	unregister()
+	unregisterEmpty()
+	WithContext(context.Background(), func() {})
+
+	want := []event{{true, outer}, {true, inner}, {false, inner}, {false, outer}}
+	if len(events) != len(want) {
+		t.Fatalf("got events %v, want %v", events, want)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("got events %v, want %v", events, want)
+			break
+		}
+	}
+	if hookSets.Load() != nil {
+		t.Errorf("hooks still registered after unregistering them all")
+	}
+}
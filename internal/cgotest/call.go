@@ -0,0 +1,25 @@
+//go:build cgo
+
+// Package cgotest calls back into Go from C, so that tests can check what
+// becomes of bindings on the far side of a cgo round trip.
+package cgotest
+
+/*
+#include <stdint.h>
+
+extern void goCallback(uintptr_t h);
+
+static void callBack(uintptr_t h) {
+	goCallback(h);
+}
+*/
+import "C"
+
+import "runtime/cgo"
+
+// CallThroughC calls f from C, which is itself called from Go.
+func CallThroughC(f func()) {
+	h := cgo.NewHandle(f)
+	defer h.Delete()
+	C.callBack(C.uintptr_t(h))
+}
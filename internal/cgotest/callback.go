@@ -0,0 +1,13 @@
+//go:build cgo
+
+package cgotest
+
+// #include <stdint.h>
+import "C"
+
+import "runtime/cgo"
+
+//export goCallback
+func goCallback(h C.uintptr_t) {
+	cgo.Handle(h).Value().(func())()
+}
@@ -0,0 +1,47 @@
+//go:build cgo
+
+package cgotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/knusbaum/glc"
+)
+
+type key struct{}
+
+func TestCallThroughC(t *testing.T) {
+	glc.WithContext(context.WithValue(context.Background(), key{}, "outer"), func() {
+		CallThroughC(func() {
+			ctx := glc.GetContext()
+			if ctx == nil {
+				t.Fatal("binding lost crossing into C and back")
+			}
+			if v := ctx.Value(key{}); v != "outer" {
+				t.Errorf("got %v, want outer", v)
+			}
+			// And again, with a binding made on the far side.
+			glc.WithContext(context.WithValue(ctx, key{}, "inner"), func() {
+				CallThroughC(func() {
+					if v := glc.GetContext().Value(key{}); v != "inner" {
+						t.Errorf("got %v, want inner", v)
+					}
+				})
+			})
+			if v := glc.GetContext().Value(key{}); v != "outer" {
+				t.Errorf("got %v, want outer", v)
+			}
+		})
+	})
+}
+
+func TestCallThroughCValue(t *testing.T) {
+	glc.EncodeInto(42, func() {
+		CallThroughC(func() {
+			if v, ok := glc.DecodeValue(); !ok || v != 42 {
+				t.Errorf("got %d, %v, want 42", v, ok)
+			}
+		})
+	})
+}
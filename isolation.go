@@ -0,0 +1,37 @@
+package glc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrBindingVisible is wrapped by the errors `AssertNoBinding` returns.
+var ErrBindingVisible = errors.New("glc: binding visible")
+
+// AssertNoBinding checks that no context is visible to `GetContext` from where
+// it is called. Call it at trust boundaries, such as before running another
+// tenant's code, to make sure no dynamic context leaks across them. If there is
+// a binding, AssertNoBinding calls the OnViolation hooks registered with
+// `RegisterHooks`, and returns an error wrapping ErrBindingVisible.
+//
+// `WithClean` is the way to make sure the check passes.
+func AssertNoBinding() error {
+	ctx, ok, id, _ := find()
+	if !ok {
+		return nil
+	}
+	if hs := hookSets.Load(); hs != nil {
+		for _, h := range *hs {
+			if h.OnViolation != nil {
+				h.OnViolation(id, ctx)
+			}
+		}
+	}
+	return fmt.Errorf("%w: binding %d", ErrBindingVisible, id)
+}
+
+// WithClean executes `f` with every binding on the stack hidden from it, so that
+// `GetContext` within `f` returns nil until `f` makes a binding of its own.
+func WithClean(f func()) {
+	encstart(cleanID, f)
+}
@@ -0,0 +1,47 @@
+package glc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestAssertNoBinding(t *testing.T) {
+	if err := AssertNoBinding(); err != nil {
+		t.Errorf("unbound: %v", err)
+	}
+
+	var violations []uint64
+	unregister := RegisterHooks(Hooks{OnViolation: func(id uint64, ctx context.Context) {
+		violations = append(violations, id)
+	}})
+	defer unregister()
+
+	WithContext(context.Background(), func() {
+		id, _ := BindingID()
+		if err := AssertNoBinding(); !errors.Is(err, ErrBindingVisible) {
+			t.Errorf("bound: got %v, want %v", err, ErrBindingVisible)
+		}
+		if len(violations) != 1 || violations[0] != id {
+			t.Errorf("got violations %v, want [%d]", violations, id)
+		}
+
+		WithClean(func() {
+			if err := AssertNoBinding(); err != nil {
+				t.Errorf("in WithClean: %v", err)
+			}
+			if ctx, err := GetContextErr(); ctx != nil || err.(*DecodeError).Kind != NoBinding {
+				t.Errorf("in WithClean: got %v, %v", ctx, err)
+			}
+			inner := context.WithValue(context.Background(), "scope", "inner")
+			WithContext(inner, func() {
+				if ctx := GetContext(); ctx != inner {
+					t.Errorf("binding within WithClean: got %v, want %v", ctx, inner)
+				}
+			})
+		})
+		if got, _ := BindingID(); got != id {
+			t.Errorf("after WithClean: got binding %d, want %d", got, id)
+		}
+	})
+}
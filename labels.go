@@ -0,0 +1,101 @@
+package glc
+
+import (
+	"context"
+	"runtime/pprof"
+	"strconv"
+	"sync/atomic"
+)
+
+// profileLabels is set while profile labelling is enabled. labels may be nil.
+type profileLabels struct {
+	labels func(ctx context.Context) []string
+}
+
+var labelling atomic.Pointer[profileLabels]
+
+// EnableProfileLabels makes `WithContext` label the goroutine for profiling for
+// the duration of each scope, so that CPU and goroutine profiles attribute
+// samples to the binding they were taken under. Every scope is labelled with
+// "glc_id", set to the binding's ID, plus the labels returned by `labels`,
+// which is called with the context being bound. `labels` returns alternating
+// keys and values, like the arguments to `pprof.Labels`, and may be nil.
+//
+// While labelling is enabled, `GetContext` returns the bound context with the
+// labels added, so that `pprof.Do` further up the stack adds to them rather
+// than replacing them.
+//
+// When a scope exits, the goroutine's labels are set back to those of the
+// context it was given, as `pprof.Do` does.
+func EnableProfileLabels(labels func(ctx context.Context) []string) {
+	labelling.Store(&profileLabels{labels: labels})
+}
+
+// DisableProfileLabels undoes `EnableProfileLabels`. Scopes that are already
+// labelled keep their labels until they exit.
+func DisableProfileLabels() {
+	labelling.Store(nil)
+}
+
+// label applies the profile labels for a binding of ctx with the given ID, if
+// labelling is enabled. It returns the labelled context to bind, and whether
+// the goroutine's labels have to be put back afterwards.
+func label(ctx context.Context, id uint64) (context.Context, bool) {
+	p := labelling.Load()
+	if p == nil {
+		return ctx, false
+	}
+	labels := []string{"glc_id", formatID(id)}
+	if p.labels != nil {
+		labels = append(labels, p.labels(ctx)...)
+	}
+	lctx := pprof.WithLabels(ctx, pprof.Labels(labels...))
+	pprof.SetGoroutineLabels(lctx)
+	return lctx, true
+}
+
+// goroutineLabelling is set while goroutine labelling is enabled.
+var goroutineLabelling atomic.Bool
+
+// EnableGoroutineLabels makes `WithContext` label the goroutine with "glc_id",
+// set to the binding's ID, for the duration of each scope, so that goroutine
+// profiles show which binding each goroutine is running under:
+//
+//	curl 'localhost:6060/debug/pprof/goroutine?debug=1'
+//
+// Unlike `EnableProfileLabels`, it only labels the goroutine, and leaves the
+// bound context as it is. When a scope exits, the goroutine is labelled with
+// the enclosing binding's ID again. Finding the enclosing binding means a
+// decode, so every `WithContext` costs about as much as a `GetContext` while
+// goroutine labelling is enabled.
+//
+// EnableProfileLabels labels the goroutine as well, and takes precedence while
+// both are enabled.
+func EnableGoroutineLabels() {
+	goroutineLabelling.Store(true)
+}
+
+// DisableGoroutineLabels undoes `EnableGoroutineLabels`. Scopes that are
+// already labelled keep their labels until they exit.
+func DisableGoroutineLabels() {
+	goroutineLabelling.Store(false)
+}
+
+// goroutineLabel labels the goroutine for a binding of ctx with the given ID,
+// if goroutine labelling is enabled. It returns the context whose labels the
+// goroutine should have once the binding's scope exits.
+func goroutineLabel(ctx context.Context, id uint64) (restore context.Context, ok bool) {
+	if !goroutineLabelling.Load() {
+		return nil, false
+	}
+	restore = ctx
+	if octx, ok, oid, _ := find(); ok {
+		restore = pprof.WithLabels(octx, pprof.Labels("glc_id", formatID(oid)))
+	}
+	pprof.SetGoroutineLabels(pprof.WithLabels(ctx, pprof.Labels("glc_id", formatID(id))))
+	return restore, true
+}
+
+func formatID(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
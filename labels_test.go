@@ -0,0 +1,112 @@
+package glc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestProfileLabels(t *testing.T) {
+	EnableProfileLabels(func(ctx context.Context) []string {
+		op, _ := ctx.Value("op").(string)
+		return []string{"op", op}
+	})
+	defer DisableProfileLabels()
+
+	WithContext(context.WithValue(context.Background(), "op", "outer"), func() {
+		ctx := GetContext()
+		id, _ := lastID()
+		if v, _ := pprof.Label(ctx, "glc_id"); v != strconv.FormatUint(id, 10) {
+			t.Errorf("glc_id label = %q, want %d", v, id)
+		}
+		if v, _ := pprof.Label(ctx, "op"); v != "outer" {
+			t.Errorf("op label = %q, want outer", v)
+		}
+		WithContext(context.WithValue(ctx, "op", "inner"), func() {
+			if v, _ := pprof.Label(GetContext(), "op"); v != "inner" {
+				t.Errorf("op label = %q, want inner", v)
+			}
+		})
+		if v, _ := pprof.Label(GetContext(), "op"); v != "outer" {
+			t.Errorf("op label = %q after inner scope, want outer", v)
+		}
+	})
+
+	DisableProfileLabels()
+	WithContext(context.Background(), func() {
+		if _, ok := pprof.Label(GetContext(), "glc_id"); ok {
+			t.Error("labelled a scope with labelling disabled")
+		}
+	})
+}
+
+// profiledLabels returns the labels of the goroutines whose stacks contain fn,
+// as a goroutine profile shows them.
+func profiledLabels(t *testing.T, fn string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range strings.Split(buf.String(), "\n\n") {
+		if !strings.Contains(record, fn) {
+			continue
+		}
+		for _, line := range strings.Split(record, "\n") {
+			if strings.HasPrefix(line, "# labels: ") {
+				return strings.TrimPrefix(line, "# labels: ")
+			}
+		}
+		return ""
+	}
+	t.Fatalf("no goroutine in %s:\n%s", fn, buf.String())
+	return ""
+}
+
+func parkOuter(ready chan<- uint64, release <-chan struct{}) {
+	id, _ := BindingID()
+	ready <- id
+	<-release
+}
+
+func parkInner(ready chan<- uint64, release <-chan struct{}) {
+	id, _ := BindingID()
+	ready <- id
+	<-release
+}
+
+func TestGoroutineLabels(t *testing.T) {
+	EnableGoroutineLabels()
+	defer DisableGoroutineLabels()
+
+	ready, release := make(chan uint64), make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		WithContext(context.WithValue(context.Background(), "op", "outer"), func() {
+			if _, ok := pprof.Label(GetContext(), "glc_id"); ok {
+				t.Error("goroutine labelling labelled the bound context")
+			}
+			WithContext(context.Background(), func() {
+				parkInner(ready, release)
+			})
+			parkOuter(ready, release)
+		})
+	}()
+
+	inner := <-ready
+	if got, want := profiledLabels(t, "parkInner"), fmt.Sprintf(`{"glc_id":"%d"}`, inner); got != want {
+		t.Errorf("inner scope labelled %s, want %s", got, want)
+	}
+	release <- struct{}{}
+	outer := <-ready
+	if got, want := profiledLabels(t, "parkOuter"), fmt.Sprintf(`{"glc_id":"%d"}`, outer); got != want {
+		t.Errorf("outer scope labelled %s after inner scope exited, want %s", got, want)
+	}
+	release <- struct{}{}
+	<-done
+}
@@ -0,0 +1,137 @@
+package glc
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Leak describes a binding that has been live for longer than a leak watchdog's
+// threshold.
+type Leak struct {
+	// ID is the binding's ID.
+	ID uint64
+	// Context is the context the binding bound.
+	Context context.Context
+	// Age is how long the binding had been live when the watchdog noticed it.
+	// For bindings made before any watchdog was running, it's how long the
+	// binding has been live since a watchdog first saw it.
+	Age time.Duration
+	// Site is where WithContext was called from. It's the zero Frame for
+	// bindings made before any watchdog was running.
+	Site runtime.Frame
+	// Stack is the traceback of the goroutine the binding is on, as
+	// DumpState finds it. It's empty if the goroutine couldn't be found.
+	Stack string
+}
+
+// leaksReported is the number of leaks reported by all watchdogs.
+var leaksReported atomic.Int64
+
+// LeaksReported returns the number of bindings reported by `WatchLeaks`
+// watchdogs since the program started. A binding reported by more than one
+// watchdog is counted once for each.
+func LeaksReported() int64 {
+	return leaksReported.Load()
+}
+
+// leakWatchers is the number of running leak watchdogs. WithContext only
+// records when and where bindings are made while it's above zero.
+var leakWatchers int32
+
+var epoch = time.Now()
+
+// sinceEpoch returns the time since epoch in nanoseconds, plus one so that it's
+// never zero.
+func sinceEpoch() int64 {
+	return int64(time.Since(epoch)) + 1
+}
+
+// WatchLeaks starts a watchdog which calls `hook` with each binding that has been
+// live for longer than `threshold`. Each binding is reported to `hook` at most
+// once. `hook` is called from the watchdog's own goroutine.
+//
+// While any watchdog is running, `WithContext` records the time and place each
+// binding is made, which makes it somewhat slower. Finding the goroutine a
+// leaked binding is on means collecting the tracebacks of every goroutine, so
+// a watchdog briefly stops the world whenever it has new leaks to report.
+//
+// WatchLeaks returns a function which stops the watchdog.
+func WatchLeaks(threshold time.Duration, hook func(Leak)) (stop func()) {
+	atomic.AddInt32(&leakWatchers, 1)
+	interval := threshold / 2
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	done := make(chan struct{})
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		var reported map[uint64]struct{}
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				reported = checkLeaks(threshold, hook, reported)
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			atomic.AddInt32(&leakWatchers, -1)
+		})
+	}
+}
+
+// checkLeaks reports bindings older than threshold to hook, except for those in
+// reported. It returns the IDs of the bindings it and previous checks have
+// reported that are still live.
+func checkLeaks(threshold time.Duration, hook func(Leak), reported map[uint64]struct{}) map[uint64]struct{} {
+	now := sinceEpoch()
+	var leaks []*binding
+	idmap.Range(func(b *binding) bool {
+		created := b.created.Load()
+		if created == 0 {
+			// Made before anybody was watching. Start the clock now.
+			b.created.CompareAndSwap(0, now)
+			return true
+		}
+		if time.Duration(now-created) >= threshold {
+			leaks = append(leaks, b)
+		}
+		return true
+	})
+
+	// Call hook outside of Range, so that it's free to make bindings of its
+	// own.
+	stillReported := make(map[uint64]struct{}, len(leaks))
+	var stacks map[uint64]string
+	for _, b := range leaks {
+		stillReported[b.id] = struct{}{}
+		if _, ok := reported[b.id]; ok {
+			continue
+		}
+		l := Leak{
+			ID:      b.id,
+			Context: b.ctx,
+			Age:     time.Duration(now - b.created.Load()),
+		}
+		if b.site[0] != 0 {
+			l.Site, _ = runtime.CallersFrames(b.site[:]).Next()
+		}
+		if stacks == nil {
+			// This stops the world, so only do it when there's something
+			// new to report.
+			stacks = goroutineBindings(allStacks())
+		}
+		l.Stack = stacks[b.id]
+		leaksReported.Add(1)
+		hook(l)
+	}
+	return stillReported
+}
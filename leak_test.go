@@ -0,0 +1,74 @@
+package glc
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatchLeaks(t *testing.T) {
+	var mu sync.Mutex
+	var leaks []Leak
+	stop := WatchLeaks(20*time.Millisecond, func(l Leak) {
+		mu.Lock()
+		leaks = append(leaks, l)
+		mu.Unlock()
+	})
+	defer stop()
+
+	// Over quickly, so not a leak.
+	WithContext(context.Background(), func() {})
+
+	reported := LeaksReported()
+	ctx := context.WithValue(context.Background(), "foo", "bar")
+	var id uint64
+	WithContext(ctx, func() {
+		id, _ = lastID()
+		time.Sleep(100 * time.Millisecond)
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(leaks) != 1 {
+		t.Fatalf("expected exactly one leak, got %v", leaks)
+	}
+	if n := LeaksReported() - reported; n != 1 {
+		t.Errorf("LeaksReported went up by %d, want 1", n)
+	}
+	l := leaks[0]
+	if l.ID != id || l.Context != ctx {
+		t.Errorf("got leak of binding %d with context %v, want %d with %v", l.ID, l.Context, id, ctx)
+	}
+	if l.Age < 20*time.Millisecond {
+		t.Errorf("leak is only %v old", l.Age)
+	}
+	if !strings.HasSuffix(l.Site.Function, "TestWatchLeaks") {
+		t.Errorf("expected leak to be made by TestWatchLeaks, got %q", l.Site.Function)
+	}
+	if !strings.Contains(l.Stack, "TestWatchLeaks") {
+		t.Errorf("expected the leak's goroutine to be running TestWatchLeaks, got stack:\n%s", l.Stack)
+	}
+}
+
+func TestWatchLeaksExisting(t *testing.T) {
+	leaked := make(chan Leak, 1)
+	WithContext(context.Background(), func() {
+		stop := WatchLeaks(10*time.Millisecond, func(l Leak) {
+			select {
+			case leaked <- l:
+			default:
+			}
+		})
+		defer stop()
+		select {
+		case l := <-leaked:
+			if l.Site.PC != 0 {
+				t.Errorf("expected no site for a binding made before watching, got %v", l.Site)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("binding made before watching was never reported")
+		}
+	})
+}
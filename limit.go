@@ -0,0 +1,79 @@
+package glc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// droppedID is the ID encoded on the stack in place of a binding dropped by
+// the limit, and cleanID the one encoded by WithClean. nextID never hands
+// either out: it starts at 1, and would take centuries to reach cleanID.
+const (
+	droppedID = 0
+	cleanID   = 1<<64 - 1
+)
+
+// hiddenKind says whether id is one of the IDs that hide the bindings outside
+// them, and if it is, what kind of failure to report for it.
+func hiddenKind(id uint64) (DecodeFailureKind, bool) {
+	switch id {
+	case droppedID:
+		return Dropped, true
+	case cleanID:
+		return NoBinding, true
+	}
+	return 0, false
+}
+
+var maxBindings atomic.Int64
+var droppedBindings atomic.Int64
+
+// ErrTooManyBindings is returned by `WithContextErr` when the limit set by
+// `SetMaxBindings` has been reached.
+var ErrTooManyBindings = errors.New("glc: too many bindings")
+
+// SetMaxBindings limits the number of bindings that may be live at once,
+// across all goroutines, to `n`. Zero or less means no limit, which is the
+// default.
+//
+// Once the limit is reached, `WithContextErr` returns ErrTooManyBindings
+// without calling its function, and `WithContext` calls its function with no
+// context bound, rather than with the enclosing scope's, and counts it in
+// `DroppedBindings`. Neither blocks, since a scope waiting for room inside
+// another scope could wait forever.
+//
+// The limit is checked before each binding is made but not held while it is,
+// so goroutines binding at the same moment can overshoot it by a few.
+func SetMaxBindings(n int) {
+	maxBindings.Store(int64(n))
+}
+
+// DroppedBindings returns the number of `WithContext` scopes that ran with no
+// context bound because the limit set by `SetMaxBindings` had been reached.
+func DroppedBindings() int64 {
+	return droppedBindings.Load()
+}
+
+// WithContextErr is `WithContext`, except that if the limit set by
+// `SetMaxBindings` has been reached, it returns ErrTooManyBindings without
+// executing `f`.
+func WithContextErr(ctx context.Context, f func()) error {
+	if overBindingLimit() {
+		return ErrTooManyBindings
+	}
+	bind(ctx, f, 1)
+	return nil
+}
+
+func overBindingLimit() bool {
+	max := maxBindings.Load()
+	return max > 0 && idmap.live.Load() >= max
+}
+
+// dropBinding executes f with droppedID encoded on the stack, which hides any
+// enclosing binding from GetContext.
+func dropBinding(f func()) {
+	droppedBindings.Add(1)
+	encstart(droppedID, f)
+}
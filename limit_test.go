@@ -0,0 +1,49 @@
+package glc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMaxBindings(t *testing.T) {
+	outer := context.WithValue(context.Background(), "scope", "outer")
+	WithContext(outer, func() {
+		SetMaxBindings(ActiveBindings())
+		defer SetMaxBindings(0)
+
+		ran := false
+		if err := WithContextErr(context.Background(), func() { ran = true }); err != ErrTooManyBindings || ran {
+			t.Errorf("WithContextErr at the limit returned %v and ran %t", err, ran)
+		}
+
+		dropped := DroppedBindings()
+		WithContext(context.Background(), func() {
+			ran = true
+			if ctx := GetContext(); ctx != nil {
+				t.Errorf("dropped scope got context %v, want none", ctx)
+			}
+			var de *DecodeError
+			if _, err := GetContextErr(); !errors.As(err, &de) || de.Kind != Dropped {
+				t.Errorf("dropped scope got error %v, want %v", err, Dropped)
+			}
+			if id, ok := BindingID(); ok {
+				t.Errorf("dropped scope has binding %d", id)
+			}
+		})
+		if !ran || DroppedBindings()-dropped != 1 {
+			t.Errorf("WithContext at the limit ran %t and dropped %d", ran, DroppedBindings()-dropped)
+		}
+		if ctx := GetContext(); ctx != outer {
+			t.Errorf("after the dropped scope, got %v, want %v", ctx, outer)
+		}
+
+		SetMaxBindings(ActiveBindings() + 1)
+		ran = false
+		if err := WithContextErr(context.Background(), func() {
+			ran = GetContext() != nil
+		}); err != nil || !ran {
+			t.Errorf("WithContextErr under the limit returned %v, and its scope had a context: %t", err, ran)
+		}
+	})
+}
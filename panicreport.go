@@ -0,0 +1,83 @@
+package glc
+
+import (
+	"context"
+	"reflect"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// PanicReporter is called with a panic escaping a `WithContext` scope.
+type PanicReporter func(id uint64, ctx context.Context, recovered any, stack []byte)
+
+var panicReporter atomic.Pointer[PanicReporter]
+
+// SetPanicReporter makes `WithContext` call `r` when `f` panics, with the ID
+// and context of the binding, the value passed to panic, and the stack trace of
+// the panicking goroutine as formatted by debug.Stack. r is called on the
+// panicking goroutine, before the panic carries on unwinding. A nil r stops
+// the reporting.
+//
+// A panic is reported once, by the innermost scope it escapes, however many
+// scopes it goes on to escape. (Scopes tell by the panic value, so if the panic
+// is recovered and another with an equal value escapes an enclosing scope,
+// that one goes unreported. A value that panics when compared, such as a
+// struct holding a slice in an interface field, is reported by every scope it
+// escapes.) Panics that are recovered within `f` aren't reported, and neither
+// is panic(nil), which can't be told apart from runtime.Goexit.
+//
+// Reporting means recovering the panic and panicking again with the same value,
+// so a program that crashes prints the panic twice, the second time with the
+// stack of the scope that reported it. The stack passed to r is the original.
+func SetPanicReporter(r func(id uint64, ctx context.Context, recovered any, stack []byte)) {
+	if r == nil {
+		panicReporter.Store(nil)
+		return
+	}
+	p := PanicReporter(r)
+	panicReporter.Store(&p)
+}
+
+// reportPanic is deferred by bind while there is a panic reporter. It runs
+// after the binding has been deleted.
+func reportPanic(b *binding, r PanicReporter) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	if b.reported == nil || !samePanic(*b.reported, v) {
+		r(b.id, b.ctx, v, debug.Stack())
+		// Tell the enclosing scope not to report this again. This
+		// scope's frames are still on the stack, but its binding has
+		// been deleted, so the decode passes over them to the enclosing
+		// scope's.
+		if _, ok, id, _ := find(); ok {
+			if outer, ok := idmap.LoadBinding(id); ok {
+				outer.reported = &v
+			}
+		}
+	}
+	panic(v)
+}
+
+// samePanic is whether a and b are the same panic value, as far as can be
+// told.
+func samePanic(a, b any) (same bool) {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	if !reflect.TypeOf(a).Comparable() {
+		// Nothing else could have changed the value's type, so assume
+		// it's the same panic.
+		return true
+	}
+	// A comparable type can still hold an uncomparable value, such as a
+	// struct with a slice in an interface field, and comparing that panics.
+	// Reporting the panic again is better than replacing it with our own.
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
@@ -0,0 +1,113 @@
+package glc
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+type panicReport struct {
+	id        uint64
+	ctx       context.Context
+	recovered any
+	stack     string
+}
+
+func TestPanicReporter(t *testing.T) {
+	var reports []panicReport
+	SetPanicReporter(func(id uint64, ctx context.Context, recovered any, stack []byte) {
+		reports = append(reports, panicReport{id, ctx, recovered, string(stack)})
+	})
+	defer SetPanicReporter(nil)
+
+	outerCtx := context.WithValue(context.Background(), "scope", "outer")
+	innerCtx := context.WithValue(context.Background(), "scope", "inner")
+	var inner uint64
+	err := Recovered(func() {
+		WithContext(outerCtx, func() {
+			WithContext(innerCtx, func() {
+				inner, _ = BindingID()
+				panicky()
+			})
+		})
+	})
+	if err == nil {
+		t.Fatal("panic didn't escape the scopes")
+	}
+	if len(reports) != 1 {
+		t.Fatalf("got %d reports, want 1", len(reports))
+	}
+	r := reports[0]
+	if r.id != inner || r.ctx != innerCtx || r.recovered != "boom" {
+		t.Errorf("got report %d, %v, %v, want %d, %v, boom", r.id, r.ctx, r.recovered, inner, innerCtx)
+	}
+	if !strings.Contains(r.stack, "panicky") {
+		t.Errorf("stack doesn't include the panic site:\n%s", r.stack)
+	}
+
+	// A second panic from the outer scope, after the first was recovered,
+	// is reported on its own.
+	reports = nil
+	Recovered(func() {
+		WithContext(outerCtx, func() {
+			Recovered(func() {
+				WithContext(innerCtx, func() { panic("first") })
+			})
+			panic("second")
+		})
+	})
+	if len(reports) != 2 {
+		t.Errorf("got %d reports, want 2", len(reports))
+	}
+
+	// Neither recovered panics nor Goexit are reported.
+	reports = nil
+	WithContext(outerCtx, func() {
+		Recovered(func() { panic("recovered") })
+	})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		WithContext(outerCtx, runtime.Goexit)
+	}()
+	<-done
+	if len(reports) != 0 {
+		t.Errorf("got %d reports, want none", len(reports))
+	}
+}
+
+// uncomparable is comparable as a type, but comparing it panics while V holds a
+// slice.
+type uncomparable struct{ V any }
+
+func TestPanicReporterUncomparable(t *testing.T) {
+	var reports int
+	SetPanicReporter(func(id uint64, ctx context.Context, recovered any, stack []byte) {
+		reports++
+	})
+	defer SetPanicReporter(nil)
+
+	var got any
+	func() {
+		defer func() { got = recover() }()
+		WithContext(context.Background(), func() {
+			WithContext(context.Background(), func() {
+				panic(uncomparable{[]int{1}})
+			})
+		})
+	}()
+	if v, ok := got.(uncomparable); !ok {
+		t.Fatalf("recovered %v, want the uncomparable value", got)
+	} else if s, ok := v.V.([]int); !ok || len(s) != 1 || s[0] != 1 {
+		t.Errorf("recovered %v, want {[1]}", v)
+	}
+	if reports != 2 {
+		t.Errorf("got %d reports, want 2", reports)
+	}
+}
+
+//go:noinline
+func panicky() {
+	panic("boom")
+}
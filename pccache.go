@@ -0,0 +1,69 @@
+package glc
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// pcCacheSize is the number of slots in the pc cache. It must be a power of two.
+//
+// Every encoding function contains 256 call sites, so there are a little over
+// 65536 return addresses that can show up in an encoding. In practice the IDs in
+// use at any one time share most of their high bytes, so the set of return
+// addresses a running program sees is much smaller than that.
+const pcCacheSize = 1 << 12
+
+// pcCacheProbe is the number of slots entryForPC looks at before giving up and
+// asking the runtime.
+const pcCacheProbe = 8
+
+// pcCache maps return addresses to the entry points of the functions containing
+// them. It is append-only and lock-free: a slot is claimed by CASing its pc from
+// zero, and its entry is written afterwards. Since the entry for a pc never
+// changes, a reader that catches a slot before its entry is written just falls
+// back to the runtime.
+var pcCache [pcCacheSize]struct {
+	pc    uintptr
+	entry uintptr
+}
+
+// entryForPC returns the entry point of the function containing pc, or zero if
+// there isn't one, without calling FuncForPC if it has seen pc before.
+func entryForPC(pc uintptr) uintptr {
+	h := pcHash(pc)
+	for i := uintptr(0); i < pcCacheProbe; i++ {
+		slot := &pcCache[(h+i)&(pcCacheSize-1)]
+		switch atomic.LoadUintptr(&slot.pc) {
+		case pc:
+			if e := atomic.LoadUintptr(&slot.entry); e != 0 {
+				return e
+			}
+			return funcEntry(pc)
+		case 0:
+			e := funcEntry(pc)
+			if e != 0 && atomic.CompareAndSwapUintptr(&slot.pc, 0, pc) {
+				atomic.StoreUintptr(&slot.entry, e)
+			}
+			return e
+		}
+	}
+	// The neighbourhood is full. This only happens once a program has seen a
+	// few thousand distinct return addresses, and costs us no more than we
+	// paid before there was a cache.
+	return funcEntry(pc)
+}
+
+// funcEntry is runtime.FuncForPC(pc).Entry(), except that it returns zero for
+// addresses outside of any function rather than panicking.
+func funcEntry(pc uintptr) uintptr {
+	if f := runtime.FuncForPC(pc); f != nil {
+		return f.Entry()
+	}
+	return 0
+}
+
+func pcHash(pc uintptr) uintptr {
+	// Fibonacci hashing. Return addresses within a function are close together,
+	// so the multiplication spreads them out across the table.
+	return uintptr(uint64(pc)*0x9E3779B97F4A7C15>>32) & (pcCacheSize - 1)
+}
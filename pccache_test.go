@@ -0,0 +1,24 @@
+package glc
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestEntryForPC(t *testing.T) {
+	var pcs []uintptr
+	encstart(0x0123456789abcdef, func() {
+		stackit(50, func() {
+			var buf [100]uintptr
+			pcs = append(pcs, buf[:runtime.Callers(0, buf[:])]...)
+		})
+	})
+	// Twice, so the second pass is served from the cache.
+	for i := 0; i < 2; i++ {
+		for _, pc := range pcs {
+			if got, want := entryForPC(pc), runtime.FuncForPC(pc).Entry(); got != want {
+				t.Errorf("entryForPC(%#x) = %#x, want %#x", pc, got, want)
+			}
+		}
+	}
+}
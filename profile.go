@@ -0,0 +1,93 @@
+package glc
+
+import (
+	"math/bits"
+	"sync/atomic"
+	"time"
+)
+
+var profiling atomic.Bool
+
+// EnableProfiling makes every decode, by `GetContext` and friends or by
+// `DecodeValue`, record how long it took and how many stack frames it looked
+// at, for `Profile` to report. It costs a couple of calls to time.Now per
+// decode.
+func EnableProfiling() {
+	profiling.Store(true)
+}
+
+// DisableProfiling undoes `EnableProfiling`. What has been recorded so far is
+// kept.
+func DisableProfiling() {
+	profiling.Store(false)
+}
+
+// DecodeProfile is a snapshot of the histograms kept while profiling is
+// enabled. Both are in power-of-two buckets: Latency[i] counts the decodes that
+// took at least 1<<(i-1) nanoseconds but less than 1<<i, and Frames[i] those
+// that looked at at least 1<<(i-1) frames but fewer than 1<<i. The last
+// bucket of each also counts everything bigger.
+type DecodeProfile struct {
+	// Decodes is the number of decodes recorded.
+	Decodes int64
+	Latency [40]int64
+	Frames  [24]int64
+}
+
+// LatencyQuantile returns the upper bound of the bucket holding the q'th
+// quantile of decode latency, or zero if nothing has been recorded.
+func (p *DecodeProfile) LatencyQuantile(q float64) time.Duration {
+	return time.Duration(quantile(p.Latency[:], p.Decodes, q))
+}
+
+// FramesQuantile is `LatencyQuantile` for the number of frames looked at.
+func (p *DecodeProfile) FramesQuantile(q float64) int {
+	return int(quantile(p.Frames[:], p.Decodes, q))
+}
+
+func quantile(hist []int64, n int64, q float64) int64 {
+	if n == 0 {
+		return 0
+	}
+	rank := int64(q * float64(n-1))
+	for i, c := range hist {
+		if rank -= c; rank < 0 {
+			return 1 << i
+		}
+	}
+	return 1 << (len(hist) - 1)
+}
+
+var decodeProfile struct {
+	latency [len(DecodeProfile{}.Latency)]atomic.Int64
+	frames  [len(DecodeProfile{}.Frames)]atomic.Int64
+}
+
+// Profile returns the histograms of decode latency and frames looked at
+// recorded while profiling was enabled.
+func Profile() DecodeProfile {
+	var p DecodeProfile
+	for i := range p.Latency {
+		p.Latency[i] = decodeProfile.latency[i].Load()
+		p.Decodes += p.Latency[i]
+	}
+	for i := range p.Frames {
+		p.Frames[i] = decodeProfile.frames[i].Load()
+	}
+	return p
+}
+
+func recordDecode(d time.Duration, frames int) {
+	if d < 0 {
+		d = 0
+	}
+	decodeProfile.latency[bucketOf(uint64(d), len(decodeProfile.latency))].Add(1)
+	decodeProfile.frames[bucketOf(uint64(frames), len(decodeProfile.frames))].Add(1)
+}
+
+func bucketOf(v uint64, n int) int {
+	if b := bits.Len64(v); b < n {
+		return b
+	}
+	return n - 1
+}
@@ -0,0 +1,80 @@
+package glc
+
+import (
+	"context"
+	"io"
+	"runtime/pprof"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestCPUProfiling decodes contexts while the CPU profiler is interrupting every
+// goroutine with SIGPROF.
+func TestCPUProfiling(t *testing.T) {
+	// StartCPUProfile profiles at 100Hz. Setting a higher rate first works,
+	// but makes the runtime complain on stderr, so the test runs for longer
+	// instead. The error is for a profile that's already running, as with
+	// go test -cpuprofile.
+	if err := pprof.StartCPUProfile(io.Discard); err != nil {
+		t.Skipf("can't profile: %v", err)
+	}
+	defer pprof.StopCPUProfile()
+
+	var stop int32
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			WithContext(context.WithValue(context.Background(), stressKey{}, g), func() {
+				for i := 0; atomic.LoadInt32(&stop) == 0; i++ {
+					stackit(i%100, func() {
+						ctx := GetContext()
+						if ctx == nil || ctx.Value(stressKey{}) != g {
+							t.Errorf("goroutine %d: got context %v", g, ctx)
+						}
+					})
+				}
+			})
+		}(g)
+	}
+	time.Sleep(time.Second)
+	atomic.StoreInt32(&stop, 1)
+	wg.Wait()
+}
+
+func TestDecodeProfile(t *testing.T) {
+	before := Profile()
+	EnableProfiling()
+	WithContext(context.Background(), func() {
+		stackit(500, func() {
+			for i := 0; i < 10; i++ {
+				GetContext()
+			}
+		})
+	})
+	DisableProfiling()
+	GetContext()
+	after := Profile()
+
+	if n := after.Decodes - before.Decodes; n != 10 {
+		t.Fatalf("recorded %d decodes, want 10", n)
+	}
+	var diff DecodeProfile
+	diff.Decodes = 10
+	for i := range diff.Frames {
+		diff.Frames[i] = after.Frames[i] - before.Frames[i]
+	}
+	for i := range diff.Latency {
+		diff.Latency[i] = after.Latency[i] - before.Latency[i]
+	}
+	// stackit(500) puts the binding between 512 and 1023 frames away.
+	if got := diff.FramesQuantile(0.5); got != 1024 {
+		t.Errorf("median frames looked at is under %d, want under 1024", got)
+	}
+	if got := diff.LatencyQuantile(1); got <= 0 {
+		t.Errorf("slowest decode took under %v", got)
+	}
+}
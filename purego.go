@@ -0,0 +1,15 @@
+//go:build purego
+
+package glc
+
+// Building with the purego tag makes decoding use nothing but
+// runtime.Callers and runtime.CallersFrames, which name each frame's function:
+//
+//	go build -tags purego
+//
+// The decoder recognizes the encoding functions by their names rather than by
+// where they are in memory, so it uses no table of their addresses, nor any
+// arithmetic on addresses that could be wrong. It's much slower than the default,
+// since it looks up every frame and copies the whole stack, but it's easy to
+// check that it's correct.
+const purego = true
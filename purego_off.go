@@ -0,0 +1,6 @@
+//go:build !purego
+
+package glc
+
+// purego is whether this is a purego build. See purego.go.
+const purego = false
@@ -0,0 +1,56 @@
+package glc
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error `Recovered` returns in place of a panic.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// ID is the ID of the innermost binding where the panic happened, which
+	// may be one made within the function passed to Recovered. Bound is false
+	// if there was no binding, in which case ID is zero.
+	ID    uint64
+	Bound bool
+	// Stack is the stack trace of the panicking goroutine, as formatted by
+	// debug.Stack.
+	Stack []byte
+}
+
+// Error describes the panic and the binding it happened under. It does not
+// include the stack trace.
+func (e *PanicError) Error() string {
+	if !e.Bound {
+		return fmt.Sprintf("panic: %v", e.Value)
+	}
+	return fmt.Sprintf("panic: %v [glc_id=%d]", e.Value, e.ID)
+}
+
+// Unwrap returns the panic value if it is an error, so errors.Is and errors.As
+// see through a PanicError to whatever was panicked with.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Recovered calls `f`, and returns a *PanicError if it panics, or nil if it
+// returns normally. The error records which binding the panic happened under,
+// so recovery middleware can tell which request a panic belongs to even when
+// nothing passed it a context.
+func Recovered(f func()) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			// The panicking frames are still on the stack while deferred
+			// calls run, so this finds the binding at the panic site.
+			id, ok := lastID()
+			if _, hidden := hiddenKind(id); hidden {
+				id, ok = 0, false
+			}
+			err = &PanicError{Value: v, ID: id, Bound: ok, Stack: debug.Stack()}
+		}
+	}()
+	f()
+	return nil
+}
@@ -0,0 +1,44 @@
+package glc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestRecovered(t *testing.T) {
+	if err := Recovered(func() {}); err != nil {
+		t.Errorf("Recovered returned %v without a panic", err)
+	}
+
+	var pe *PanicError
+	err := Recovered(func() { panic(io.EOF) })
+	if !errors.As(err, &pe) || pe.Bound || !errors.Is(err, io.EOF) {
+		t.Errorf("unbound panic: got %#v", err)
+	}
+
+	WithContext(context.Background(), func() {
+		outer, _ := BindingID()
+		var inner uint64
+		err := Recovered(func() {
+			WithContext(context.Background(), func() {
+				inner, _ = BindingID()
+				stackit(50, func() { panic("boom") })
+			})
+		})
+		if !errors.As(err, &pe) {
+			t.Fatalf("got %v, want a *PanicError", err)
+		}
+		if !pe.Bound || pe.ID != inner || pe.ID == outer {
+			t.Errorf("got ID %d (bound %t), want the inner binding %d", pe.ID, pe.Bound, inner)
+		}
+		if pe.Value != "boom" || !bytes.Contains(pe.Stack, []byte("TestRecovered")) {
+			t.Errorf("got value %v, stack:\n%s", pe.Value, pe.Stack)
+		}
+		if id, _ := BindingID(); id != outer {
+			t.Errorf("after recovery, BindingID is %d, want %d", id, outer)
+		}
+	})
+}
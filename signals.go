@@ -0,0 +1,26 @@
+package glc
+
+import (
+	"context"
+	"os"
+	"os/signal"
+)
+
+// WithSignals executes the function `f` with the dynamic context bound to a
+// context which is cancelled when the process receives any of `sigs`, or when
+// the currently bound context is cancelled. If no context is currently bound,
+// the new one is derived from `context.Background()`.
+//
+// As with `signal.NotifyContext`, the signals stop being delivered to the
+// context when `f` returns. While `f` runs, they no longer have their default
+// behavior, so the first signal doesn't kill the process; it's up to `f` to
+// notice that the context is done and return.
+func WithSignals(f func(), sigs ...os.Signal) {
+	parent := GetContext()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(parent, sigs...)
+	defer stop()
+	WithContext(ctx, f)
+}
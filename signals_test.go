@@ -0,0 +1,28 @@
+//go:build unix
+
+package glc
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWithSignals(t *testing.T) {
+	parent := context.WithValue(context.Background(), "foo", "bar")
+	WithContext(parent, func() {
+		WithSignals(func() {
+			ctx := GetContext()
+			if ctx.Value("foo") != "bar" {
+				t.Error("signal context isn't derived from the bound context")
+			}
+			syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+				t.Error("context wasn't cancelled by the signal")
+			}
+		}, syscall.SIGUSR1)
+	})
+}
@@ -0,0 +1,73 @@
+package glc
+
+import (
+	"time"
+)
+
+// Sleep pauses for at least the duration `d`, like `time.Sleep`, but returns
+// early if the bound context is done first. It returns the context's error if
+// it returned early, and nil otherwise. If no context is bound, Sleep is
+// `time.Sleep`.
+func Sleep(d time.Duration) error {
+	ctx := GetContext()
+	if ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RetryPolicy says how many times `Retry` tries, and how long it waits in
+// between.
+type RetryPolicy struct {
+	// Attempts is the most times Retry calls f. Zero means no limit other
+	// than the bound context.
+	Attempts int
+	// Delay is how long Retry waits after the first failure.
+	Delay time.Duration
+	// Multiplier is what the delay is multiplied by after each failure after
+	// the first. Values below 1 are taken as 1, so the delay stays constant.
+	Multiplier float64
+	// MaxDelay is the longest Retry will wait between attempts. Zero means
+	// no limit.
+	MaxDelay time.Duration
+}
+
+// Retry calls `f` until it returns nil, waiting between calls as `policy`
+// says. It gives up when it has made `policy.Attempts` calls, or when the bound
+// context is done, and returns the error from the last call to `f`.
+//
+// If the bound context is already done when Retry is called, Retry returns the
+// context's error without calling `f` at all.
+func Retry(policy RetryPolicy, f func() error) error {
+	ctx := GetContext()
+	if ctx != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	delay := policy.Delay
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if policy.Attempts > 0 && attempt >= policy.Attempts {
+			return err
+		}
+		if Sleep(delay) != nil {
+			return err
+		}
+		if policy.Multiplier > 1 {
+			delay = time.Duration(float64(delay) * policy.Multiplier)
+		}
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package glc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSleep(t *testing.T) {
+	if err := Sleep(time.Millisecond); err != nil {
+		t.Errorf("unbound Sleep returned %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	WithContext(ctx, func() {
+		start := time.Now()
+		if err := Sleep(time.Hour); err != context.DeadlineExceeded {
+			t.Errorf("Sleep returned %v, want %v", err, context.DeadlineExceeded)
+		}
+		if d := time.Since(start); d > 5*time.Second {
+			t.Errorf("Sleep took %v to notice its context expired", d)
+		}
+	})
+}
+
+func TestRetry(t *testing.T) {
+	errFail := errors.New("fail")
+
+	calls := 0
+	err := Retry(RetryPolicy{Delay: time.Millisecond, Multiplier: 2}, func() error {
+		calls++
+		if calls < 3 {
+			return errFail
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("Retry returned %v after %d calls, want nil after 3", err, calls)
+	}
+
+	calls = 0
+	err = Retry(RetryPolicy{Attempts: 4}, func() error {
+		calls++
+		return errFail
+	})
+	if err != errFail || calls != 4 {
+		t.Errorf("Retry returned %v after %d calls, want %v after 4", err, calls, errFail)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	WithContext(ctx, func() {
+		calls = 0
+		err = Retry(RetryPolicy{Delay: time.Hour}, func() error {
+			calls++
+			cancel()
+			return errFail
+		})
+		if err != errFail || calls != 1 {
+			t.Errorf("Retry returned %v after %d calls, want %v after 1", err, calls, errFail)
+		}
+
+		calls = 0
+		err = Retry(RetryPolicy{}, func() error {
+			calls++
+			return nil
+		})
+		if err != context.Canceled || calls != 0 {
+			t.Errorf("Retry returned %v after %d calls, want %v after 0", err, calls, context.Canceled)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package glc
+
+//go:generate bash -c "./gentags.sh >tags.go && gofmt -w tags.go"
+
+// maxTagLength is the most characters of a tag StackTag puts on the stack.
+const maxTagLength = 64
+
+// StackTag calls `f` through a chain of functions spelling out `tag`, one
+// character per frame, so that the tag can be read straight off of panic
+// traces and goroutine dumps, with no decoding. Within f, the traceback of the
+// goroutine includes, reading down from f:
+//
+//	github.com/knusbaum/glc.tag_c(...)
+//	github.com/knusbaum/glc.tag_h(...)
+//	github.com/knusbaum/glc.tag_e(...)
+//	...
+//	github.com/knusbaum/glc.tagstart(...)
+//
+// Tags can hold letters, digits and underscores. Any other byte is shown as an
+// underscore, and only the first 64 bytes are shown. StackTag has nothing to do
+// with `WithContext`, and doesn't change what `GetContext` returns.
+func StackTag(tag string, f func()) {
+	if len(tag) > maxTagLength {
+		tag = tag[:maxTagLength]
+	}
+	tagstart(tag, f)
+}
@@ -0,0 +1,57 @@
+package glc
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+// readTags reads the tags StackTag has put in a traceback, innermost first.
+func readTags(stack string) []string {
+	var tags []string
+	var tag []byte
+	for _, line := range strings.Split(stack, "\n") {
+		name, _, _ := strings.Cut(line, "(")
+		switch {
+		case strings.HasSuffix(name, "glc.tagstart"):
+			tags = append(tags, string(tag))
+			tag = nil
+		case strings.Contains(name, "glc.tag_"):
+			tag = append(tag, name[len(name)-1])
+		}
+	}
+	return tags
+}
+
+func TestStackTag(t *testing.T) {
+	var stack string
+	StackTag("checkout-7", func() {
+		StackTag("", func() {
+			StackTag(strings.Repeat("x", 100), func() {
+				stack = string(debug.Stack())
+			})
+		})
+	})
+	got := readTags(stack)
+	want := []string{strings.Repeat("x", maxTagLength), "", "checkout_7"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got tags %q, want %q in:\n%s", got, want, stack)
+	}
+
+	// Tags don't get in the way of bindings, and show up in panics.
+	ctx := context.WithValue(context.Background(), "k", "v")
+	err := Recovered(func() {
+		WithContext(ctx, func() {
+			StackTag("panicking", func() {
+				if got := GetContext(); got != ctx {
+					t.Errorf("got context %v, want %v", got, ctx)
+				}
+				panic("boom")
+			})
+		})
+	})
+	if tags := readTags(string(err.(*PanicError).Stack)); len(tags) != 1 || tags[0] != "panicking" {
+		t.Errorf("got tags %q from panic", tags)
+	}
+}
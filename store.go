@@ -0,0 +1,197 @@
+package glc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// ringSize is the number of slots in a bindingStore's ring. It must be a power
+// of two.
+const ringSize = 1 << 12
+
+// bindingStore maps binding IDs to their contexts. IDs are handed out by a
+// counter and most bindings are short-lived, so the live IDs at any moment are
+// mostly a small, dense range. bindingStore keeps them in a ring indexed by ID,
+// which makes a lookup an index and a compare, with no hashing and no locks.
+//
+// A binding that lives long enough for the counter to come all the way around
+// the ring keeps its slot, and the IDs that would have used it go to an idStore
+// instead.
+type bindingStore struct {
+	ring     [ringSize]atomic.Pointer[binding]
+	overflow idStore
+
+	// live is the number of bindings in the store, and maxLive the most
+	// there have ever been.
+	live, maxLive atomic.Int64
+}
+
+// binding is everything we know about a live binding.
+type binding struct {
+	id  uint64
+	ctx context.Context
+
+	// created is when the binding was made, in nanoseconds since epoch, or
+	// zero if nobody was watching for leaks at the time. See leak.go.
+	created atomic.Int64
+	// site is the return address into whatever called WithContext, if
+	// created was recorded.
+	site [1]uintptr
+	// reported points to the panic value a scope within this one has passed
+	// to the panic reporter, if any. It's only used on the binding's own
+	// goroutine. See panicreport.go.
+	reported *any
+}
+
+func (s *bindingStore) Store(b *binding) {
+	live := s.live.Add(1)
+	for max := s.maxLive.Load(); live > max && !s.maxLive.CompareAndSwap(max, live); max = s.maxLive.Load() {
+	}
+	if s.ring[b.id&(ringSize-1)].CompareAndSwap(nil, b) {
+		return
+	}
+	s.overflow.Store(b)
+}
+
+func (s *bindingStore) Load(id uint64) (context.Context, bool) {
+	if b, ok := s.LoadBinding(id); ok {
+		return b.ctx, true
+	}
+	return nil, false
+}
+
+// LoadBinding is Load, but returns the whole binding.
+func (s *bindingStore) LoadBinding(id uint64) (*binding, bool) {
+	// The ID check is what makes this safe: a slot holding some other ID
+	// belongs to a binding that went around the ring, or was made after ours
+	// was deleted.
+	if b := s.ring[id&(ringSize-1)].Load(); b != nil && b.id == id {
+		return b, true
+	}
+	return s.overflow.Load(id)
+}
+
+func (s *bindingStore) Delete(id uint64) {
+	s.live.Add(-1)
+	slot := &s.ring[id&(ringSize-1)]
+	if b := slot.Load(); b != nil && b.id == id {
+		slot.CompareAndSwap(b, nil)
+		return
+	}
+	s.overflow.Delete(id)
+}
+
+// Range calls f with each live binding until f returns false. Bindings made or
+// deleted while Range is running may or may not be seen.
+func (s *bindingStore) Range(f func(b *binding) bool) {
+	for i := range s.ring {
+		if b := s.ring[i].Load(); b != nil && !f(b) {
+			return
+		}
+	}
+	s.overflow.Range(f)
+}
+
+// idShards is the number of shards in an idStore. It must be a power of two.
+const idShards = 64
+
+// idStore maps binding IDs to their contexts. It's what bindingStore falls back
+// on for IDs that can't have their slot in the ring. IDs come from a counter, so
+// consecutive bindings land in consecutive shards, and bindings made at the
+// same time by different goroutines rarely share a lock.
+type idStore struct {
+	shards [idShards]idShard
+}
+
+type idShard struct {
+	mu sync.RWMutex
+	m  map[uint64]*binding
+	// peak is the most entries m has held since it was made. Go maps never
+	// give memory back, so once m is down to a small fraction of peak, Delete
+	// replaces it with a smaller one.
+	peak int
+	// Keep each shard's lock on its own cache line, so that goroutines
+	// working on neighbouring shards don't fight over it.
+	_ [64]byte
+}
+
+func (s *idStore) shard(id uint64) *idShard {
+	return &s.shards[id&(idShards-1)]
+}
+
+func (s *idStore) Store(b *binding) {
+	sh := s.shard(b.id)
+	sh.mu.Lock()
+	if sh.m == nil {
+		sh.m = make(map[uint64]*binding)
+	}
+	sh.m[b.id] = b
+	if len(sh.m) > sh.peak {
+		sh.peak = len(sh.m)
+	}
+	sh.mu.Unlock()
+}
+
+func (s *idStore) Load(id uint64) (*binding, bool) {
+	sh := s.shard(id)
+	sh.mu.RLock()
+	b, ok := sh.m[id]
+	sh.mu.RUnlock()
+	return b, ok
+}
+
+// compactPeak is the smallest peak at which an idShard's map is worth replacing,
+// and compactRatio how far below its peak the map has to fall first.
+const (
+	compactPeak  = 1024
+	compactRatio = 8
+)
+
+// reclaimedSlots is the total number of entries' worth of space given back by
+// replacing shard maps.
+var reclaimedSlots atomic.Int64
+
+func (s *idStore) Delete(id uint64) {
+	sh := s.shard(id)
+	sh.mu.Lock()
+	delete(sh.m, id)
+	if sh.peak >= compactPeak && len(sh.m) <= sh.peak/compactRatio {
+		m := make(map[uint64]*binding, 2*len(sh.m))
+		for id, b := range sh.m {
+			m[id] = b
+		}
+		reclaimedSlots.Add(int64(sh.peak - len(sh.m)))
+		sh.m, sh.peak = m, len(m)
+	}
+	sh.mu.Unlock()
+}
+
+// Len returns the number of entries in the store, and the sum of the shards'
+// peaks, which is roughly how many entries' worth of memory the maps hold.
+func (s *idStore) Len() (n, peak int) {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		n += len(sh.m)
+		peak += sh.peak
+		sh.mu.RUnlock()
+	}
+	return n, peak
+}
+
+// Range calls f with each binding in the store until f returns false. f must not
+// modify the store.
+func (s *idStore) Range(f func(b *binding) bool) {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		for _, b := range sh.m {
+			if !f(b) {
+				sh.mu.RUnlock()
+				return
+			}
+		}
+		sh.mu.RUnlock()
+	}
+}
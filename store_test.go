@@ -0,0 +1,80 @@
+package glc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIDStore(t *testing.T) {
+	var s idStore
+	bs := make(map[uint64]*binding)
+	for id := uint64(0); id < 4*idShards; id++ {
+		bs[id] = &binding{id: id, ctx: context.WithValue(context.Background(), "id", id)}
+		s.Store(bs[id])
+	}
+	for id, want := range bs {
+		if got, ok := s.Load(id); !ok || got != want {
+			t.Errorf("Load(%d) = %v, %v, want %v", id, got, ok, want)
+		}
+		if id%2 == 0 {
+			s.Delete(id)
+		}
+	}
+	for id := range bs {
+		if _, ok := s.Load(id); ok != (id%2 == 1) {
+			t.Errorf("Load(%d) found = %v after deleting evens", id, ok)
+		}
+	}
+}
+
+func TestBindingStore(t *testing.T) {
+	var s bindingStore
+	long := context.WithValue(context.Background(), "id", "long")
+	s.Store(&binding{id: 1, ctx: long})
+	// Go all the way around the ring while 1 is still live, so that
+	// 1+ringSize has to overflow.
+	for id := uint64(2); id <= 2*ringSize+1; id++ {
+		ctx := context.WithValue(context.Background(), "id", id)
+		s.Store(&binding{id: id, ctx: ctx})
+		if got, ok := s.Load(id); !ok || got != ctx {
+			t.Fatalf("Load(%d) = %v, %v, want %v", id, got, ok, ctx)
+		}
+		s.Delete(id)
+		if got, ok := s.Load(id); ok {
+			t.Fatalf("Load(%d) = %v after Delete", id, got)
+		}
+	}
+	if got, ok := s.Load(1); !ok || got != long {
+		t.Errorf("Load(1) = %v, %v, want %v", got, ok, long)
+	}
+	// A slot holding some other ID must not answer for ours.
+	if got, ok := s.Load(1 + ringSize); ok {
+		t.Errorf("Load(%d) = %v, expected nothing", 1+ringSize, got)
+	}
+	s.Delete(1)
+	if _, ok := s.Load(1); ok {
+		t.Error("Load(1) found a binding after Delete")
+	}
+}
+
+func TestIDStoreCompacts(t *testing.T) {
+	var s idStore
+	before := ReclaimedBindingSlots()
+	n := uint64(compactPeak * compactRatio * idShards)
+	for id := uint64(0); id < n; id++ {
+		s.Store(&binding{id: id})
+	}
+	for id := uint64(0); id < n; id++ {
+		if id%(2*compactRatio) != 0 {
+			s.Delete(id)
+		}
+	}
+	if ReclaimedBindingSlots() == before {
+		t.Error("expected shard maps to be replaced after most of their entries were deleted")
+	}
+	for id := uint64(0); id < n; id++ {
+		if _, ok := s.Load(id); ok != (id%(2*compactRatio) == 0) {
+			t.Fatalf("Load(%d) found = %v after compaction", id, ok)
+		}
+	}
+}
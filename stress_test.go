@@ -0,0 +1,93 @@
+package glc
+
+import (
+	"context"
+	"flag"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var stress = flag.Bool("stress", false, "run TestStress with thousands of goroutines for much longer")
+
+type stressKey struct{}
+
+// TestStress runs many concurrent WithContext/GetContext pairs at varied depths
+// while the garbage collector runs continuously, so goroutine stacks are being
+// grown, copied, and shrunk underneath the decoder. It is quick by default. Run
+// it with -stress (and -race) for the full treatment:
+//
+//	go test -race -run TestStress -stress
+func TestStress(t *testing.T) {
+	goroutines, duration := 50, 500*time.Millisecond
+	if *stress {
+		goroutines, duration = 2000, 30*time.Second
+	}
+
+	var stop int32
+	var gcwg sync.WaitGroup
+	gcwg.Add(1)
+	go func() {
+		defer gcwg.Done()
+		for i := 0; atomic.LoadInt32(&stop) == 0; i++ {
+			// GC shrinks stacks that are using less than a quarter of their
+			// space, which moves them.
+			if i%10 == 0 {
+				debug.FreeOSMemory()
+			} else {
+				runtime.GC()
+			}
+		}
+	}()
+
+	var wrong, missing, checks int64
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; atomic.LoadInt32(&stop) == 0; i++ {
+				want := g<<32 | i
+				depth := (g + i) % 300
+				WithContext(context.WithValue(context.Background(), stressKey{}, want), func() {
+					stackit(depth, func() {
+						check := func(want int) {
+							atomic.AddInt64(&checks, 1)
+							ctx := GetContext()
+							if ctx == nil {
+								atomic.AddInt64(&missing, 1)
+								return
+							}
+							if got := ctx.Value(stressKey{}); got != want {
+								atomic.AddInt64(&wrong, 1)
+								t.Errorf("goroutine %d: got context for %v, want %v", g, got, want)
+							}
+						}
+						check(want)
+						// Rebind further up the stack, and grow the stack past
+						// whatever it has been shrunk to.
+						WithContext(context.WithValue(context.Background(), stressKey{}, -want), func() {
+							stackit(depth, func() {
+								check(-want)
+							})
+						})
+						check(want)
+					})
+				})
+			}
+		}(g)
+	}
+
+	time.Sleep(duration)
+	atomic.StoreInt32(&stop, 1)
+	wg.Wait()
+	gcwg.Wait()
+
+	t.Logf("%d checks, %d wrong, %d missing", checks, wrong, missing)
+	if missing > 0 {
+		t.Errorf("%d of %d checks found no context", missing, checks)
+	}
+}
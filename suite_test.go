@@ -0,0 +1,12 @@
+package glc_test
+
+import (
+	"testing"
+
+	"github.com/knusbaum/glc"
+	"github.com/knusbaum/glc/glctest"
+)
+
+func TestStoreSuite(t *testing.T) {
+	glctest.StoreSuite(t, func() glctest.Store { return glc.NewTestStore() })
+}
@@ -0,0 +1,239 @@
+package glc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+// syntheticBase is where the functions of a synthetic stack pretend to be.
+// It's nowhere near any real code.
+const syntheticBase = 0x10000
+
+// syntheticStack returns a stack made of the frames described, innermost first,
+// and replaces exttable with one describing made-up functions for them, until
+// the end of the test. Each frame is one of
+//
+//	end    encend
+//	start  encstart
+//	value  encvalue, the caller of encstart within EncodeInto
+//	other  some function that has nothing to do with encodings
+//	xx     the encoding function for the byte xx, in hex
+//
+// This lets scanExtents be tested with stacks that would be hard or impossible
+// to get the runtime to produce. scanRuntime asks the runtime about real
+// functions, and can't be fooled like this; FuzzDecoder tests it with stacks
+// of real addresses instead.
+func syntheticStack(t *testing.T, frames ...string) []uintptr {
+	t.Helper()
+	// The made-up function for each extent is 1<<extshift bytes long, and
+	// is at syntheticBase plus the extent times that. extNone's is
+	// "other".
+	table, base, shift := exttable, extbase, extshift
+	t.Cleanup(func() { exttable, extbase, extshift = table, base, shift })
+	fake := make([]uint16, extValue+1)
+	for i := range fake {
+		fake[i] = uint16(i)
+	}
+	exttable, extbase, extshift = fake, syntheticBase, 4
+
+	stack := make([]uintptr, len(frames))
+	for i, f := range frames {
+		var ext uint16
+		switch f {
+		case "end":
+			ext = extEnd
+		case "start":
+			ext = extStart
+		case "value":
+			ext = extValue
+		case "other":
+			ext = extNone
+		default:
+			b, err := strconv.ParseUint(f, 16, 8)
+			if err != nil {
+				t.Fatalf("bad synthetic frame %q", f)
+			}
+			ext = uint16(b) + 1
+		}
+		// A return address, somewhere in the middle of the function.
+		stack[i] = syntheticBase + uintptr(ext)<<4 + 5
+	}
+	return stack
+}
+
+// syntheticEncoding returns the frames of the encoding of v, from encend to
+// encstart's caller, as described to syntheticStack. An ID's encoding is
+// called from WithContext, and a value's from encvalue.
+func syntheticEncoding(v uint64, kind encoding) []string {
+	frames := []string{"end"}
+	for i := encodingBytes - 1; i >= 0; i-- {
+		frames = append(frames, fmt.Sprintf("%02x", byte(v>>(8*i))))
+	}
+	caller := "other"
+	if kind == encodingValue {
+		caller = "value"
+	}
+	return append(frames, "start", caller)
+}
+
+// concat concatenates lists of frames.
+func concat(fs ...[]string) []string {
+	var all []string
+	for _, f := range fs {
+		all = append(all, f...)
+	}
+	return all
+}
+
+// decodeStack decodes stack the way chunkedlast does, chunk frames at a time.
+func decodeStack(stack []uintptr, want encoding, live func(uint64) bool, chunk int) (uint64, bool, DecodeFailureKind) {
+	d := idDecoder{want: want, live: live}
+	for rest := stack; len(rest) > 0; {
+		n := chunk
+		if n > len(rest) {
+			n = len(rest)
+		}
+		if v, ok, done := d.scan(rest[:n]); done {
+			return v, ok, 0
+		}
+		rest = rest[n:]
+	}
+	return d.missed()
+}
+
+func TestSyntheticDecode(t *testing.T) {
+	const id, outer, val = 0x0102030405060708, 0x1122334455667788, 0xfffefdfcfbfaf9f8
+	dead := func(v uint64) bool { return v != id }
+	for _, tc := range []struct {
+		name   string
+		frames []string
+		want   encoding
+		live   func(uint64) bool
+		v      uint64
+		ok     bool
+		miss   DecodeFailureKind
+	}{
+		{
+			name:   "empty",
+			frames: nil,
+			miss:   NoBinding,
+		},
+		{
+			name:   "id",
+			frames: concat([]string{"other", "other"}, syntheticEncoding(id, encodingID), []string{"other"}),
+			v:      id, ok: true,
+		},
+		{
+			name:   "value within id",
+			frames: concat(syntheticEncoding(val, encodingValue), syntheticEncoding(id, encodingID)),
+			v:      id, ok: true,
+		},
+		{
+			name:   "value wanted",
+			frames: concat(syntheticEncoding(id, encodingID), syntheticEncoding(val, encodingValue)),
+			want:   encodingValue,
+			v:      val, ok: true,
+		},
+		{
+			name:   "no value",
+			frames: syntheticEncoding(id, encodingID),
+			want:   encodingValue,
+			miss:   NoBinding,
+		},
+		{
+			name: "unrelated frames within the encoding",
+			frames: []string{
+				"end", "other", "01", "02", "other", "other", "03", "04",
+				"05", "06", "07", "other", "08", "other", "start", "other",
+			},
+			v: id, ok: true,
+		},
+		{
+			name:   "truncated",
+			frames: syntheticEncoding(id, encodingID)[:6],
+			miss:   TruncatedStack,
+		},
+		{
+			name:   "truncated before the caller of encstart",
+			frames: syntheticEncoding(id, encodingID)[:10],
+			miss:   TruncatedStack,
+		},
+		{
+			name:   "truncated after an encoding",
+			frames: concat(syntheticEncoding(outer, encodingID)[:4], syntheticEncoding(id, encodingID)),
+			v:      id, ok: true,
+		},
+		{
+			name:   "wrong order",
+			frames: concat([]string{"start", "08", "07", "06", "05", "04", "03", "02", "01", "end"}, syntheticEncoding(id, encodingID)),
+			v:      id, ok: true,
+		},
+		{
+			name:   "too few bytes",
+			frames: concat([]string{"end", "01", "02", "start", "other"}, syntheticEncoding(id, encodingID)),
+			v:      id, ok: true,
+		},
+		{
+			name:   "too many bytes",
+			frames: concat([]string{"end", "00"}, syntheticEncoding(outer, encodingID)[1:], syntheticEncoding(id, encodingID)),
+			v:      id, ok: true,
+		},
+		{
+			name:   "duplicated encend",
+			frames: concat([]string{"end", "end", "03"}, syntheticEncoding(id, encodingID)),
+			v:      id, ok: true,
+		},
+		{
+			name:   "dead binding",
+			frames: concat(syntheticEncoding(id, encodingID), syntheticEncoding(outer, encodingID)),
+			live:   dead,
+			v:      outer, ok: true,
+		},
+		{
+			name:   "only a dead binding",
+			frames: syntheticEncoding(id, encodingID),
+			live:   dead,
+			v:      id,
+			miss:   UnknownID,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stack := syntheticStack(t, tc.frames...)
+			for chunk := 1; chunk <= len(stack)+1; chunk++ {
+				v, ok, miss := decodeStack(stack, tc.want, tc.live, chunk)
+				if v != tc.v || ok != tc.ok || miss != tc.miss {
+					t.Fatalf("in chunks of %d: got (%#x, %t, %v), want (%#x, %t, %v)", chunk, v, ok, miss, tc.v, tc.ok, tc.miss)
+				}
+			}
+		})
+	}
+}
+
+// TestScanNamed checks the decoder purego builds use against the default one,
+// whichever this build uses.
+func TestScanNamed(t *testing.T) {
+	named := func(want encoding) (uint64, bool, DecodeFailureKind) {
+		d := idDecoder{want: want}
+		return d.scanNamed()
+	}
+	if v, ok, miss := named(encodingID); ok || miss != NoBinding {
+		t.Errorf("unbound: got (%d, %t, %v)", v, ok, miss)
+	}
+	for _, depth := range []int{0, decodeChunk, 1000} {
+		WithContext(context.Background(), func() {
+			EncodeInto(uint64(depth), func() {
+				stackit(depth, func() {
+					id, _ := BindingID()
+					if v, ok, _ := named(encodingID); !ok || v != id {
+						t.Errorf("depth %d: got ID (%d, %t), want %d", depth, v, ok, id)
+					}
+					if v, ok, _ := named(encodingValue); !ok || v != uint64(depth) {
+						t.Errorf("depth %d: got value (%d, %t), want %d", depth, v, ok, depth)
+					}
+				})
+			})
+		})
+	}
+}
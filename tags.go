@@ -0,0 +1,8705 @@
+package glc
+
+//go:noinline
+func tagstart(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_a(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_b(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_c(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_d(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_e(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_f(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_g(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_h(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_i(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_j(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_k(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_l(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_m(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_n(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_o(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_p(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_q(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_r(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_s(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_t(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_u(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_v(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_w(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_x(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_y(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_z(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_A(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_B(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_C(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_D(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_E(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_F(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_G(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_H(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_I(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_J(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_K(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_L(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_M(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_N(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_O(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_P(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_Q(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_R(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_S(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_T(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_U(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_V(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_W(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_X(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_Y(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_Z(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_0(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_1(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_2(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_3(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_4(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_5(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_6(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_7(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_8(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag_9(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
+
+//go:noinline
+func tag__(rest string, f func()) {
+	if len(rest) == 0 {
+		f()
+		return
+	}
+	switch rest[len(rest)-1] {
+	case 'a':
+		tag_a(rest[:len(rest)-1], f)
+	case 'b':
+		tag_b(rest[:len(rest)-1], f)
+	case 'c':
+		tag_c(rest[:len(rest)-1], f)
+	case 'd':
+		tag_d(rest[:len(rest)-1], f)
+	case 'e':
+		tag_e(rest[:len(rest)-1], f)
+	case 'f':
+		tag_f(rest[:len(rest)-1], f)
+	case 'g':
+		tag_g(rest[:len(rest)-1], f)
+	case 'h':
+		tag_h(rest[:len(rest)-1], f)
+	case 'i':
+		tag_i(rest[:len(rest)-1], f)
+	case 'j':
+		tag_j(rest[:len(rest)-1], f)
+	case 'k':
+		tag_k(rest[:len(rest)-1], f)
+	case 'l':
+		tag_l(rest[:len(rest)-1], f)
+	case 'm':
+		tag_m(rest[:len(rest)-1], f)
+	case 'n':
+		tag_n(rest[:len(rest)-1], f)
+	case 'o':
+		tag_o(rest[:len(rest)-1], f)
+	case 'p':
+		tag_p(rest[:len(rest)-1], f)
+	case 'q':
+		tag_q(rest[:len(rest)-1], f)
+	case 'r':
+		tag_r(rest[:len(rest)-1], f)
+	case 's':
+		tag_s(rest[:len(rest)-1], f)
+	case 't':
+		tag_t(rest[:len(rest)-1], f)
+	case 'u':
+		tag_u(rest[:len(rest)-1], f)
+	case 'v':
+		tag_v(rest[:len(rest)-1], f)
+	case 'w':
+		tag_w(rest[:len(rest)-1], f)
+	case 'x':
+		tag_x(rest[:len(rest)-1], f)
+	case 'y':
+		tag_y(rest[:len(rest)-1], f)
+	case 'z':
+		tag_z(rest[:len(rest)-1], f)
+	case 'A':
+		tag_A(rest[:len(rest)-1], f)
+	case 'B':
+		tag_B(rest[:len(rest)-1], f)
+	case 'C':
+		tag_C(rest[:len(rest)-1], f)
+	case 'D':
+		tag_D(rest[:len(rest)-1], f)
+	case 'E':
+		tag_E(rest[:len(rest)-1], f)
+	case 'F':
+		tag_F(rest[:len(rest)-1], f)
+	case 'G':
+		tag_G(rest[:len(rest)-1], f)
+	case 'H':
+		tag_H(rest[:len(rest)-1], f)
+	case 'I':
+		tag_I(rest[:len(rest)-1], f)
+	case 'J':
+		tag_J(rest[:len(rest)-1], f)
+	case 'K':
+		tag_K(rest[:len(rest)-1], f)
+	case 'L':
+		tag_L(rest[:len(rest)-1], f)
+	case 'M':
+		tag_M(rest[:len(rest)-1], f)
+	case 'N':
+		tag_N(rest[:len(rest)-1], f)
+	case 'O':
+		tag_O(rest[:len(rest)-1], f)
+	case 'P':
+		tag_P(rest[:len(rest)-1], f)
+	case 'Q':
+		tag_Q(rest[:len(rest)-1], f)
+	case 'R':
+		tag_R(rest[:len(rest)-1], f)
+	case 'S':
+		tag_S(rest[:len(rest)-1], f)
+	case 'T':
+		tag_T(rest[:len(rest)-1], f)
+	case 'U':
+		tag_U(rest[:len(rest)-1], f)
+	case 'V':
+		tag_V(rest[:len(rest)-1], f)
+	case 'W':
+		tag_W(rest[:len(rest)-1], f)
+	case 'X':
+		tag_X(rest[:len(rest)-1], f)
+	case 'Y':
+		tag_Y(rest[:len(rest)-1], f)
+	case 'Z':
+		tag_Z(rest[:len(rest)-1], f)
+	case '0':
+		tag_0(rest[:len(rest)-1], f)
+	case '1':
+		tag_1(rest[:len(rest)-1], f)
+	case '2':
+		tag_2(rest[:len(rest)-1], f)
+	case '3':
+		tag_3(rest[:len(rest)-1], f)
+	case '4':
+		tag_4(rest[:len(rest)-1], f)
+	case '5':
+		tag_5(rest[:len(rest)-1], f)
+	case '6':
+		tag_6(rest[:len(rest)-1], f)
+	case '7':
+		tag_7(rest[:len(rest)-1], f)
+	case '8':
+		tag_8(rest[:len(rest)-1], f)
+	case '9':
+		tag_9(rest[:len(rest)-1], f)
+	default:
+		tag__(rest[:len(rest)-1], f)
+	}
+}
@@ -0,0 +1,73 @@
+package glc
+
+import (
+	"context"
+	"runtime"
+	"runtime/trace"
+	"sync/atomic"
+)
+
+// traceTasks is set while trace tasks are enabled. name may be nil.
+type traceTasks struct {
+	name func(ctx context.Context) string
+}
+
+var tasking atomic.Pointer[traceTasks]
+
+// EnableTraceTasks makes `WithContext` create a `runtime/trace` task for each
+// scope while an execution trace is being collected, ending the task when the
+// scope exits. Execution traces then group the work done in each scope.
+//
+// The task is named by `name`, which is called with the context being bound. If
+// `name` is nil, or returns "", the task is named after the function that called
+// `WithContext`.
+//
+// While tasks are enabled and a trace is being collected, `GetContext` returns
+// the bound context with the task added, so `trace.NewTask` and
+// `trace.WithRegion` further up the stack attach to it.
+func EnableTraceTasks(name func(ctx context.Context) string) {
+	tasking.Store(&traceTasks{name: name})
+}
+
+// DisableTraceTasks undoes `EnableTraceTasks`.
+func DisableTraceTasks() {
+	tasking.Store(nil)
+}
+
+// task creates a trace task for a binding of ctx, if tasks are enabled and a
+// trace is running. skip is the number of frames between task and the caller of
+// WithContext. It returns the context to bind, and the task to end when the
+// scope exits, which is nil if there isn't one.
+func task(ctx context.Context, skip int) (context.Context, *trace.Task) {
+	t := tasking.Load()
+	if t == nil || !trace.IsEnabled() {
+		return ctx, nil
+	}
+	var name string
+	if t.name != nil {
+		name = t.name(ctx)
+	}
+	if name == "" {
+		name = "glc"
+		if pc, _, _, ok := runtime.Caller(skip + 1); ok {
+			if f := runtime.FuncForPC(pc); f != nil {
+				name = f.Name()
+			}
+		}
+	}
+	return trace.NewTask(ctx, name)
+}
+
+// Region executes `f` in a `runtime/trace` region named `name`, attached to the
+// task in the bound context, if there is one.
+func Region(name string, f func()) {
+	if !trace.IsEnabled() {
+		f()
+		return
+	}
+	ctx := GetContext()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	trace.WithRegion(ctx, name, f)
+}
@@ -0,0 +1,52 @@
+package glc
+
+import (
+	"bytes"
+	"context"
+	"runtime/trace"
+	"testing"
+)
+
+type taskKey struct{}
+
+func TestTraceTasks(t *testing.T) {
+	EnableTraceTasks(func(ctx context.Context) string {
+		name, _ := ctx.Value(taskKey{}).(string)
+		return name
+	})
+	defer DisableTraceTasks()
+
+	// Not tracing, so no task.
+	ctx := context.Background()
+	WithContext(ctx, func() {
+		if GetContext() != ctx {
+			t.Error("made a task without a trace running")
+		}
+	})
+
+	var buf bytes.Buffer
+	if err := trace.Start(&buf); err != nil {
+		t.Skipf("can't trace: %v", err)
+	}
+	defer trace.Stop()
+
+	ctx = context.WithValue(context.Background(), taskKey{}, "named")
+	WithContext(ctx, func() {
+		got := GetContext()
+		if got == ctx {
+			t.Error("expected the bound context to carry a task")
+		}
+		if got.Value(taskKey{}) != "named" {
+			t.Error("task context lost the bound context's values")
+		}
+		ran := false
+		Region("region", func() { ran = true })
+		if !ran {
+			t.Error("Region didn't run f")
+		}
+	})
+	// Named after the caller.
+	WithContext(context.Background(), func() {
+		Region("region", func() {})
+	})
+}
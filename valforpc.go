@@ -0,0 +1,69 @@
+package glc
+
+import "math/bits"
+
+// There are three ways to get from an encoding function's entry point to the byte
+// it encodes: the switch in valForPCSwitch, the map encmap, and the table
+// encslice. generate.sh writes all three into encoder.go. Which one valForPC
+// uses is chosen by build tag, so that the others can still be checked against
+// the tests:
+//
+//	go test -tags glc_valswitch ./...
+//	go test -tags glc_valmap ./...
+//
+// Without either tag, valForPC uses encslice, which BenchmarkValForPC shows to
+// be the fastest by some distance.
+
+// encslice holds the byte encoded by each encoding function, plus one, indexed by
+// the function's offset from encslicebase shifted right by encsliceshift. Zero
+// means there is no encoding function at that offset.
+//
+// Function entry points are aligned, so the shift loses nothing but the zeros at
+// the bottom of the offsets.
+var encslice []uint16
+var encslicebase uintptr
+var encsliceshift uint
+
+// fillEncslice builds encslice from the entry points of the encoding
+// functions, indexed by the byte each encodes. The generated initEncslice calls
+// it.
+func fillEncslice(pcs *[256]uintptr) {
+	encslicebase = ^uintptr(0)
+	var top uintptr
+	for _, pc := range pcs {
+		if pc < encslicebase {
+			encslicebase = pc
+		}
+		if pc > top {
+			top = pc
+		}
+	}
+	var offsets uintptr
+	for _, pc := range pcs {
+		offsets |= pc - encslicebase
+	}
+	encsliceshift = uint(bits.TrailingZeros64(uint64(offsets)))
+	if offsets == 0 {
+		encsliceshift = 0
+	}
+	encslice = make([]uint16, (top-encslicebase)>>encsliceshift+1)
+	for v, pc := range pcs {
+		encslice[(pc-encslicebase)>>encsliceshift] = uint16(v) + 1
+	}
+}
+
+func valForPCSlice(pc uintptr) (byte, bool) {
+	if pc < encslicebase || (pc-encslicebase)&(1<<encsliceshift-1) != 0 {
+		return 0, false
+	}
+	i := (pc - encslicebase) >> encsliceshift
+	if i >= uintptr(len(encslice)) || encslice[i] == 0 {
+		return 0, false
+	}
+	return byte(encslice[i] - 1), true
+}
+
+func valForPCMap(pc uintptr) (byte, bool) {
+	v, ok := encmap[pc]
+	return v, ok
+}
@@ -0,0 +1,7 @@
+//go:build glc_valmap && !glc_valswitch
+
+package glc
+
+func valForPC(pc uintptr) (byte, bool) {
+	return valForPCMap(pc)
+}
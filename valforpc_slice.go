@@ -0,0 +1,7 @@
+//go:build !glc_valswitch && !glc_valmap
+
+package glc
+
+func valForPC(pc uintptr) (byte, bool) {
+	return valForPCSlice(pc)
+}
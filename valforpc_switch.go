@@ -0,0 +1,7 @@
+//go:build glc_valswitch
+
+package glc
+
+func valForPC(pc uintptr) (byte, bool) {
+	return valForPCSwitch(pc)
+}
@@ -0,0 +1,56 @@
+package glc
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+var valForPCs = []struct {
+	name string
+	f    func(uintptr) (byte, bool)
+}{
+	{"switch", valForPCSwitch},
+	{"map", valForPCMap},
+	{"slice", valForPCSlice},
+}
+
+func TestValForPC(t *testing.T) {
+	var others []uintptr
+	for _, f := range []interface{}{encstart, encend, encvalue, valForPC, runtime.Callers} {
+		others = append(others, uintptr(reflect.ValueOf(f).UnsafePointer()))
+	}
+	for _, vf := range valForPCs {
+		for pc, want := range encmap {
+			if got, ok := vf.f(pc); !ok || got != want {
+				t.Errorf("%s: valForPC(%#x) = %#x, %v, want %#x", vf.name, pc, got, ok, want)
+			}
+			// A return address within the function is not its entry point.
+			if got, ok := vf.f(pc + 1); ok {
+				t.Errorf("%s: valForPC(%#x) = %#x, expected nothing", vf.name, pc+1, got)
+			}
+		}
+		for _, pc := range append(others, 0, ^uintptr(0)) {
+			if got, ok := vf.f(pc); ok {
+				t.Errorf("%s: valForPC(%#x) = %#x, expected nothing", vf.name, pc, got)
+			}
+		}
+	}
+}
+
+func BenchmarkValForPC(b *testing.B) {
+	pcs := make([]uintptr, 0, len(encmap))
+	for pc := range encmap {
+		pcs = append(pcs, pc)
+	}
+	for _, vf := range valForPCs {
+		b.Run(vf.name, func(b *testing.B) {
+			var sum byte
+			for i := 0; i < b.N; i++ {
+				v, _ := vf.f(pcs[i%len(pcs)])
+				sum += v
+			}
+			_ = sum
+		})
+	}
+}